@@ -0,0 +1,2 @@
+# terraform import yandex_alb_virtual_host_route.<resource Name> <http_router_id>/<vhost_name>/<route_name>
+terraform import yandex_alb_virtual_host_route.my_route ds7ph**********hm4in/my-virtual-host/my-api-route
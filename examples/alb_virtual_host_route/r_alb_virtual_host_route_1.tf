@@ -0,0 +1,24 @@
+//
+// Add a route to an ALB Virtual Host managed elsewhere
+//
+resource "yandex_alb_virtual_host_route" "my-route" {
+  http_router_id    = yandex_alb_http_router.my-router.id
+  virtual_host_name = yandex_alb_virtual_host.my-vhost.name
+  name              = "my-api-route"
+
+  position {
+    before = "my-route"
+  }
+
+  http_route {
+    http_match {
+      path {
+        prefix = "/api/"
+      }
+    }
+    http_route_action {
+      backend_group_id = yandex_alb_backend_group.my-bg.id
+      timeout          = "3s"
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_api_gateway.<resource Name> <resource Id>
+terraform import yandex_api_gateway.test-api-gateway d5dm1**********q2kbd
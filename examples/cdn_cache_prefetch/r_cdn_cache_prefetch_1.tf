@@ -0,0 +1,13 @@
+//
+// Load the files of a CDN Resource into the cache after the site is deployed
+//
+resource "yandex_cdn_cache_prefetch" "my_prefetch" {
+  resource_id = yandex_cdn_resource.my_resource.id
+  paths       = ["/index.html", "/static/app.js"]
+
+  triggers = {
+    index_hash = yandex_storage_object.index.source_hash
+  }
+
+  depends_on = [yandex_cdn_cache_purge.my_purge]
+}
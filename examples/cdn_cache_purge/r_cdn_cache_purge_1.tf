@@ -0,0 +1,19 @@
+//
+// Purge the cache of a CDN Resource after the site is deployed
+//
+resource "yandex_cdn_cache_purge" "my_purge" {
+  resource_id = yandex_cdn_resource.my_resource.id
+  paths       = ["/index.html", "/static/*"]
+
+  triggers = {
+    index_hash = yandex_storage_object.index.source_hash
+  }
+}
+
+//
+// Purge the whole cache of a CDN Resource
+//
+resource "yandex_cdn_cache_purge" "my_purge_all" {
+  resource_id = yandex_cdn_resource.my_resource.id
+  purge_all   = true
+}
@@ -0,0 +1,29 @@
+//
+// Render cloud-init user data for a Compute Instance.
+//
+data "yandex_cloudinit_config" "web" {
+  users = [{
+    name                = "ubuntu"
+    groups              = ["sudo"]
+    sudo                = "ALL=(ALL) NOPASSWD:ALL"
+    shell               = "/bin/bash"
+    ssh_authorized_keys = [file("~/.ssh/id_ed25519.pub")]
+  }]
+
+  package_update = true
+  packages       = ["nginx"]
+
+  write_files = [{
+    path        = "/var/www/html/index.html"
+    content     = "<h1>Hello</h1>"
+    permissions = "0644"
+  }]
+
+  runcmd = ["systemctl enable --now nginx"]
+}
+
+resource "yandex_compute_instance" "web" {
+  # ...
+
+  metadata = data.yandex_cloudinit_config.web.metadata
+}
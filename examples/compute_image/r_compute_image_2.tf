@@ -0,0 +1,8 @@
+//
+// Create a new Compute Image from a local file.
+//
+resource "yandex_compute_image" "local-image" {
+  name           = "my-local-image"
+  source_file    = "${path.module}/images/ubuntu.qcow2"
+  staging_bucket = "my-image-staging-bucket"
+}
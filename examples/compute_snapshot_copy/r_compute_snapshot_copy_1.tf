@@ -0,0 +1,18 @@
+//
+// Copy the latest snapshot of a disk to a backup folder.
+//
+data "yandex_compute_snapshots" "prod" {
+  folder_id      = "prod_folder_id"
+  source_disk_id = "prod_disk_id"
+}
+
+resource "yandex_compute_snapshot_copy" "backup" {
+  name               = "prod-disk-backup"
+  source_snapshot_id = data.yandex_compute_snapshots.prod.latest_snapshot_id
+  folder_id          = "backup_folder_id"
+  zone               = "ru-central1-a"
+
+  labels = {
+    purpose = "dr"
+  }
+}
@@ -0,0 +1,11 @@
+//
+// Get the snapshots of a disk taken during the last week.
+//
+data "yandex_compute_snapshots" "last_week" {
+  source_disk_id = "some_disk_id"
+  created_after  = timeadd(timestamp(), "-168h")
+}
+
+output "latest_snapshot" {
+  value = data.yandex_compute_snapshots.last_week.latest_snapshot_id
+}
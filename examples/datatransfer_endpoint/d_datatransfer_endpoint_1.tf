@@ -0,0 +1,10 @@
+//
+// Get information about existing Data Transfer Endpoint.
+//
+data "yandex_datatransfer_endpoint" "my_endpoint" {
+  name = "pg-source"
+}
+
+output "endpoint_id" {
+  value = data.yandex_datatransfer_endpoint.my_endpoint.endpoint_id
+}
@@ -0,0 +1,10 @@
+//
+// Get information about existing Data Transfer.
+//
+data "yandex_datatransfer_transfer" "my_transfer" {
+  transfer_id = "some_transfer_id"
+}
+
+output "transfer_type" {
+  value = data.yandex_datatransfer_transfer.my_transfer.type
+}
@@ -0,0 +1,11 @@
+//
+// Export records of an existing DNS Zone in the zone file format.
+//
+data "yandex_dns_zone_export" "export" {
+  dns_zone_id = yandex_dns_zone.zone1.id
+}
+
+resource "local_file" "zone" {
+  filename = "example.com.zone"
+  content  = data.yandex_dns_zone_export.export.zone_file
+}
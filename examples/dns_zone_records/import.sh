@@ -0,0 +1,3 @@
+# terraform import yandex_dns_zone_records.<resource Name> <zone_id>
+# zone_file is filled with the current records of the zone.
+terraform import yandex_dns_zone_records.records dns9m**********tducf
@@ -0,0 +1,13 @@
+//
+// Manage all records of a DNS Zone from a zone file.
+//
+resource "yandex_dns_zone" "zone1" {
+  name   = "my-public-zone"
+  zone   = "example.com."
+  public = true
+}
+
+resource "yandex_dns_zone_records" "records" {
+  zone_id   = yandex_dns_zone.zone1.id
+  zone_file = file("example.com.zone")
+}
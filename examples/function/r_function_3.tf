@@ -0,0 +1,15 @@
+//
+// Create a new Yandex Cloud Function from a source directory.
+// The directory is zipped by the provider, and the archive is uploaded to the bucket if it is too large.
+//
+resource "yandex_function" "test-function" {
+  name       = "some_name"
+  runtime    = "python312"
+  entrypoint = "index.handler"
+  memory     = "128"
+  content {
+    zip_filename   = "src"
+    exclude        = [".git", "__pycache__", "*.pyc", "tests"]
+    staging_bucket = "my-staging-bucket"
+  }
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_iam_service_account_api_key.<resource Name> <resource Id>
+terraform import yandex_iam_service_account_api_key.sa-api-key ajeke**********a5kmt
@@ -0,0 +1,2 @@
+# terraform import yandex_iam_service_account_key.<resource Name> <resource Id>
+terraform import yandex_iam_service_account_key.sa-auth-key aje8n**********dbr5g
@@ -0,0 +1,2 @@
+# terraform import yandex_iam_service_account_static_access_key.<resource Name> <resource Id>
+terraform import yandex_iam_service_account_static_access_key.sa-static-key ajes1**********8l8vl
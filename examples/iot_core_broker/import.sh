@@ -0,0 +1,2 @@
+# terraform import yandex_iot_core_broker.<resource Name> <resource Id>
+terraform import yandex_iot_core_broker.my_broker are8n**********ubb9q
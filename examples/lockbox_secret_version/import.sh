@@ -0,0 +1,2 @@
+# terraform import yandex_lockbox_secret_version.<resource Name> <{secret_id}/{version_id}>
+terraform import yandex_lockbox_secret_version.my_version e6q2a**********9t3gn/e6qsl**********ct9q3
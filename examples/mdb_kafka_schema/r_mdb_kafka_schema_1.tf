@@ -0,0 +1,69 @@
+//
+// Register the Avro schema of the messages of the "orders" topic.
+//
+resource "yandex_mdb_kafka_schema" "orders" {
+  cluster_id     = yandex_mdb_kafka_cluster.my_cluster.id
+  username       = yandex_mdb_kafka_user.schema_writer.name
+  password       = yandex_mdb_kafka_user.schema_writer.password
+  ca_certificate = file("~/.kafka/YandexInternalRootCA.crt")
+  subject        = "orders-value"
+  schema_type    = "AVRO"
+  schema = jsonencode({
+    type = "record"
+    name = "Order"
+    fields = [
+      { name = "id", type = "string" },
+      { name = "customer", type = "Customer" },
+      { name = "amount", type = "double", default = 0 },
+    ]
+  })
+
+  reference {
+    name    = "Customer"
+    subject = yandex_mdb_kafka_schema.customers.subject
+    version = yandex_mdb_kafka_schema.customers.version
+  }
+}
+
+resource "yandex_mdb_kafka_schema" "customers" {
+  cluster_id     = yandex_mdb_kafka_cluster.my_cluster.id
+  username       = yandex_mdb_kafka_user.schema_writer.name
+  password       = yandex_mdb_kafka_user.schema_writer.password
+  ca_certificate = file("~/.kafka/YandexInternalRootCA.crt")
+  subject        = "customers-value"
+  schema = jsonencode({
+    type   = "record"
+    name   = "Customer"
+    fields = [{ name = "email", type = "string" }]
+  })
+}
+
+// Auxiliary resources
+resource "yandex_mdb_kafka_user" "schema_writer" {
+  cluster_id = yandex_mdb_kafka_cluster.my_cluster.id
+  name       = "schema-writer"
+  password   = "pass1231232332"
+  permission {
+    topic_name = "*"
+    role       = "ACCESS_ROLE_SCHEMA_WRITER"
+  }
+}
+
+resource "yandex_mdb_kafka_cluster" "my_cluster" {
+  name       = "foo"
+  network_id = "c64vs98keiqc7f24pvkd"
+
+  config {
+    version          = "3.6"
+    zones            = ["ru-central1-a"]
+    assign_public_ip = true
+    schema_registry  = true
+    kafka {
+      resources {
+        resource_preset_id = "s2.micro"
+        disk_type_id       = "network-hdd"
+        disk_size          = 16
+      }
+    }
+  }
+}
@@ -0,0 +1,41 @@
+//
+// Require new schemas of a subject to be compatible with all its previous versions.
+//
+resource "yandex_mdb_kafka_schema_registry_subject" "orders" {
+  cluster_id          = yandex_mdb_kafka_cluster.my_cluster.id
+  username            = yandex_mdb_kafka_user.schema_writer.name
+  password            = yandex_mdb_kafka_user.schema_writer.password
+  ca_certificate      = file("~/.kafka/YandexInternalRootCA.crt")
+  subject             = "orders-value"
+  compatibility_level = "FULL_TRANSITIVE"
+}
+
+// Auxiliary resources
+resource "yandex_mdb_kafka_user" "schema_writer" {
+  cluster_id = yandex_mdb_kafka_cluster.my_cluster.id
+  name       = "schema-writer"
+  password   = "pass1231232332"
+  permission {
+    topic_name = "*"
+    role       = "ACCESS_ROLE_SCHEMA_WRITER"
+  }
+}
+
+resource "yandex_mdb_kafka_cluster" "my_cluster" {
+  name       = "foo"
+  network_id = "c64vs98keiqc7f24pvkd"
+
+  config {
+    version          = "3.6"
+    zones            = ["ru-central1-a"]
+    assign_public_ip = true
+    schema_registry  = true
+    kafka {
+      resources {
+        resource_preset_id = "s2.micro"
+        disk_type_id       = "network-hdd"
+        disk_size          = 16
+      }
+    }
+  }
+}
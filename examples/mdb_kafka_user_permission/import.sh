@@ -0,0 +1,2 @@
+# terraform import yandex_mdb_kafka_user_permission.<resource Name> <cluster_id>:<user_name>:<topic_name>:<role>
+terraform import yandex_mdb_kafka_user_permission.events_consumer c9qdpo3j16n8ronsvkk0:user-events:events:ACCESS_ROLE_CONSUMER
@@ -0,0 +1,45 @@
+//
+// Grant a consumer access to the topic of another team.
+//
+resource "yandex_mdb_kafka_user_permission" "events_consumer" {
+  cluster_id  = yandex_mdb_kafka_cluster.my_cluster.id
+  user_name   = yandex_mdb_kafka_user.user_events.name
+  topic_name  = yandex_mdb_kafka_topic.events.name
+  role        = "ACCESS_ROLE_CONSUMER"
+  allow_hosts = ["10.1.0.10", "10.1.0.11"]
+}
+
+// Auxiliary resources
+resource "yandex_mdb_kafka_user" "user_events" {
+  cluster_id = yandex_mdb_kafka_cluster.my_cluster.id
+  name       = "user-events"
+  password   = "pass1231232332"
+
+  lifecycle {
+    ignore_changes = [permission]
+  }
+}
+
+resource "yandex_mdb_kafka_topic" "events" {
+  cluster_id         = yandex_mdb_kafka_cluster.my_cluster.id
+  name               = "events"
+  partitions         = 4
+  replication_factor = 1
+}
+
+resource "yandex_mdb_kafka_cluster" "my_cluster" {
+  name       = "foo"
+  network_id = "c64vs98keiqc7f24pvkd"
+
+  config {
+    version = "2.8"
+    zones   = ["ru-central1-a"]
+    kafka {
+      resources {
+        resource_preset_id = "s2.micro"
+        disk_type_id       = "network-hdd"
+        disk_size          = 16
+      }
+    }
+  }
+}
@@ -0,0 +1,10 @@
+//
+// Get information about existing Sharded PostgreSQL Cluster.
+//
+data "yandex_mdb_sharded_postgresql_cluster" "foo" {
+  name = "test"
+}
+
+output "network_id" {
+  value = data.yandex_mdb_sharded_postgresql_cluster.foo.network_id
+}
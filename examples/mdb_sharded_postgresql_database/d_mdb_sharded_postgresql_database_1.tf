@@ -0,0 +1,11 @@
+//
+// Get information about existing Sharded PostgreSQL Database.
+//
+data "yandex_mdb_sharded_postgresql_database" "foo" {
+  cluster_id = "some_cluster_id"
+  name       = "testdb"
+}
+
+output "database_name" {
+  value = data.yandex_mdb_sharded_postgresql_database.foo.name
+}
@@ -0,0 +1,11 @@
+//
+// Get information about existing Sharded PostgreSQL Shard.
+//
+data "yandex_mdb_sharded_postgresql_shard" "foo" {
+  cluster_id = "some_cluster_id"
+  name       = "shard1"
+}
+
+output "mdb_postgresql_cluster_id" {
+  value = data.yandex_mdb_sharded_postgresql_shard.foo.shard_spec.mdb_postgresql
+}
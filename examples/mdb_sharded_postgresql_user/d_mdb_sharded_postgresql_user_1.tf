@@ -0,0 +1,11 @@
+//
+// Get information about existing Sharded PostgreSQL User.
+//
+data "yandex_mdb_sharded_postgresql_user" "foo" {
+  cluster_id = "some_cluster_id"
+  name       = "alice"
+}
+
+output "permissions" {
+  value = data.yandex_mdb_sharded_postgresql_user.foo.permissions
+}
@@ -6,10 +6,11 @@ resource "yandex_message_queue" "my_queue" {
   visibility_timeout_seconds = 600
   receive_wait_time_seconds  = 20
   message_retention_seconds  = 1209600
-  redrive_policy = jsonencode({
-    deadLetterTargetArn = yandex_message_queue.example_deadletter_queue.arn
-    maxReceiveCount     = 3
-  })
+
+  redrive {
+    dead_letter_target_arn = yandex_message_queue.my_deadletter_queue.arn
+    max_receive_count      = 3
+  }
 }
 
 resource "yandex_message_queue" "my_deadletter_queue" {
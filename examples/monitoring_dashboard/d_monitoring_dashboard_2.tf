@@ -0,0 +1,11 @@
+//
+// Export an existing Monitoring Dashboard to a JSON file.
+//
+data "yandex_monitoring_dashboard" "existing" {
+  dashboard_id = "some_instance_dashboard_id"
+}
+
+resource "local_file" "dashboard" {
+  filename = "${path.module}/dashboards/existing.json"
+  content  = data.yandex_monitoring_dashboard.existing.config_json
+}
@@ -0,0 +1,8 @@
+//
+// Create a new Monitoring Dashboard from a JSON document exported from the console.
+//
+resource "yandex_monitoring_dashboard" "exported" {
+  name        = "exported-dashboard"
+  title       = "Exported dashboard"
+  config_json = file("${path.module}/dashboards/exported.json")
+}
@@ -0,0 +1,22 @@
+//
+// Create a new Serverless Container that is rolled back
+// when its error rate exceeds 5% within 5 minutes after a new revision is deployed.
+//
+resource "yandex_serverless_container" "test-container" {
+  name   = "some_name"
+  memory = 256
+  image {
+    url = "cr.yandex/yc/test-image:v1"
+  }
+
+  rollout {
+    query              = "series_sum(\"serverless.containers.invocations_errors_count\"{service=\"serverless-containers\", container=\"some_name\"}) / series_sum(\"serverless.containers.invocations_count\"{service=\"serverless-containers\", container=\"some_name\"})"
+    threshold          = 0.05
+    observation_period = "5m"
+    check_interval     = "1m"
+  }
+
+  timeouts {
+    update = "15m"
+  }
+}
@@ -0,0 +1,10 @@
+//
+// Get information about existing Storage Bucket.
+//
+data "yandex_storage_bucket" "my_bucket" {
+  bucket = "my-bucket"
+}
+
+output "bucket_domain_name" {
+  value = data.yandex_storage_bucket.my_bucket.bucket_domain_name
+}
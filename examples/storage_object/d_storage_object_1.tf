@@ -0,0 +1,11 @@
+//
+// Get information about existing Storage Object.
+//
+data "yandex_storage_object" "my_object" {
+  bucket = "my-bucket"
+  key    = "path/to/object.txt"
+}
+
+output "content_type" {
+  value = data.yandex_storage_object.my_object.content_type
+}
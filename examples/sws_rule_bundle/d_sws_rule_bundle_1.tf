@@ -0,0 +1,69 @@
+//
+// Share a bundle of SWS security rules between several profiles.
+//
+data "yandex_sws_rule_bundle" "common" {
+  name = "common"
+
+  security_rule {
+    name     = "deny-tor"
+    priority = 10
+
+    rule_condition {
+      action = "DENY"
+
+      condition {
+        source_ip {
+          geo_ip_match {
+            locations = ["tor"]
+          }
+        }
+      }
+    }
+  }
+
+  security_rule {
+    name     = "smart-protection"
+    priority = 20
+
+    smart_protection {
+      mode = "API"
+    }
+  }
+}
+
+resource "yandex_sws_security_profile" "api" {
+  name           = "api-profile"
+  default_action = "ALLOW"
+
+  security_rule {
+    name     = "allow-office"
+    priority = 1
+
+    rule_condition {
+      action = "ALLOW"
+
+      condition {
+        source_ip {
+          ip_ranges_match {
+            ip_ranges = ["192.168.1.0/24"]
+          }
+        }
+      }
+    }
+  }
+
+  // The rules of the bundle get priorities 1010 and 1020.
+  rule_bundle {
+    rules_json      = data.yandex_sws_rule_bundle.common.rules_json
+    priority_offset = 1000
+  }
+}
+
+resource "yandex_sws_security_profile" "web" {
+  name           = "web-profile"
+  default_action = "ALLOW"
+
+  rule_bundle {
+    rules_json = data.yandex_sws_rule_bundle.common.rules_json
+  }
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_ydb_coordination_node.<resource Name> <connection_string>?path=<path>
+terraform import yandex_ydb_coordination_node.locks 'grpcs://ydb.serverless.yandexcloud.net:2135/?database=/ru-central1/b1g**********/etn**********?path=services/locks'
@@ -0,0 +1,8 @@
+//
+// Create a new YDB coordination node.
+//
+resource "yandex_ydb_coordination_node" "locks" {
+  connection_string     = yandex_ydb_database_serverless.database1.ydb_full_endpoint
+  path                  = "services/locks"
+  read_consistency_mode = "strict"
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_ydb_document_table.<resource Name> <document_api_endpoint>?table=<name>
+terraform import yandex_ydb_document_table.sessions 'https://docapi.serverless.yandexcloud.net/ru-central1/b1g**********/etn**********?table=sessions'
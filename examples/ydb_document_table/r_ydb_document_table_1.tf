@@ -0,0 +1,34 @@
+//
+// Create a new Document API table with an index and TTL.
+//
+resource "yandex_ydb_document_table" "sessions" {
+  document_api_endpoint = yandex_ydb_database_serverless.database1.document_api_endpoint
+  name                  = "sessions"
+  hash_key              = "user_id"
+  range_key             = "session_id"
+
+  attribute {
+    name = "user_id"
+    type = "S"
+  }
+
+  attribute {
+    name = "session_id"
+    type = "S"
+  }
+
+  attribute {
+    name = "device"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = "by_device"
+    hash_key        = "device"
+    projection_type = "KEYS_ONLY"
+  }
+
+  ttl {
+    attribute_name = "expire_at"
+  }
+}
@@ -0,0 +1,15 @@
+//
+// Create a new Document API table using the static access keys of a service account.
+//
+resource "yandex_ydb_document_table" "events" {
+  document_api_endpoint = yandex_ydb_database_serverless.database1.document_api_endpoint
+  name                  = "logs/events"
+  hash_key              = "id"
+  access_key            = yandex_iam_service_account_static_access_key.sa_key.access_key
+  secret_key            = yandex_iam_service_account_static_access_key.sa_key.secret_key
+
+  attribute {
+    name = "id"
+    type = "N"
+  }
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_ydb_group.<resource Name> <connection_string>?group=<name>
+terraform import yandex_ydb_group.readers 'grpcs://ydb.serverless.yandexcloud.net:2135/?database=/ru-central1/b1g**********/etn**********?group=readers'
@@ -0,0 +1,14 @@
+//
+// Create a YDB group and grant it read access to the database.
+//
+resource "yandex_ydb_group" "readers" {
+  connection_string = yandex_ydb_database_dedicated.database1.ydb_full_endpoint
+  name              = "readers"
+  members           = [yandex_ydb_user.reader.name]
+}
+
+resource "yandex_ydb_permission" "readers" {
+  connection_string = yandex_ydb_database_dedicated.database1.ydb_full_endpoint
+  subject           = yandex_ydb_group.readers.name
+  permissions       = ["ydb.generic.read"]
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_ydb_permission.<resource Name> <connection_string>?path=<path>&subject=<subject>
+terraform import yandex_ydb_permission.reader 'grpcs://ydb.serverless.yandexcloud.net:2135/?database=/ru-central1/b1g**********/etn**********?path=test_dir&subject=aje**********@as'
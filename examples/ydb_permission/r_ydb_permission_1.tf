@@ -0,0 +1,9 @@
+//
+// Grant read access to a YDB directory to a service account.
+//
+resource "yandex_ydb_permission" "reader" {
+  connection_string = yandex_ydb_database_dedicated.database1.ydb_full_endpoint
+  path              = "test_dir"
+  subject           = "${yandex_iam_service_account.reader.id}@as"
+  permissions       = ["ydb.generic.read"]
+}
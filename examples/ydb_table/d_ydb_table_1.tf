@@ -0,0 +1,11 @@
+//
+// Get information about existing YDB Table.
+//
+data "yandex_ydb_table" "my_table" {
+  path              = "test_dir/test_table"
+  connection_string = yandex_ydb_database_serverless.database1.ydb_full_endpoint
+}
+
+output "primary_key" {
+  value = data.yandex_ydb_table.my_table.primary_key
+}
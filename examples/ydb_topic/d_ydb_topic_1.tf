@@ -0,0 +1,11 @@
+//
+// Get information about existing YDB Topic.
+//
+data "yandex_ydb_topic" "my_topic" {
+  database_endpoint = yandex_ydb_database_serverless.database_name.ydb_full_endpoint
+  name              = "topic-test"
+}
+
+output "partitions_count" {
+  value = data.yandex_ydb_topic.my_topic.partitions_count
+}
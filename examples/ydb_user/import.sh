@@ -0,0 +1,2 @@
+# terraform import yandex_ydb_user.<resource Name> <connection_string>?user=<name>
+terraform import yandex_ydb_user.reader 'grpcs://ydb.serverless.yandexcloud.net:2135/?database=/ru-central1/b1g**********/etn**********?user=reader'
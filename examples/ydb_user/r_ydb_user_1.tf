@@ -0,0 +1,8 @@
+//
+// Create a YDB user.
+//
+resource "yandex_ydb_user" "reader" {
+  connection_string = yandex_ydb_database_dedicated.database1.ydb_full_endpoint
+  name              = "reader"
+  password          = var.reader_password
+}
@@ -66,6 +66,7 @@ require (
 	github.com/yandex-cloud/go-sdk/v2 v2.11.0
 	github.com/ydb-platform/terraform-provider-ydb v0.0.28
 	github.com/ydb-platform/ydb-go-genproto v0.0.0-20250519101544-1f330d77b70f
+	github.com/ydb-platform/ydb-go-sdk/v3 v3.115.7
 	golang.org/x/crypto v0.41.0
 	golang.org/x/exp v0.0.0-20230809150735-7b3493d9a819
 	golang.org/x/net v0.43.0
@@ -284,7 +285,6 @@ require (
 	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
 	github.com/xen0n/gosmopolitan v1.2.1 // indirect
 	github.com/yagipy/maintidx v1.0.0 // indirect
-	github.com/ydb-platform/ydb-go-sdk/v3 v3.115.7 // indirect
 	github.com/yeya24/promlinter v0.2.0 // indirect
 	github.com/ykadowak/zerologlint v0.1.2 // indirect
 	github.com/yuin/goldmark v1.7.1 // indirect
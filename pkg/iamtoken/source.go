@@ -0,0 +1,92 @@
+// Package iamtoken provides an IAM token cache shared by the clients of the provider
+// that authenticate with a bare IAM token instead of the SDK credentials.
+package iamtoken
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+)
+
+// DefaultRefreshBefore is how long before the expiration a cached token is replaced with a new one,
+// so that a token handed out to a request doesn't expire while the request is running.
+const DefaultRefreshBefore = 15 * time.Minute
+
+// CreateFunc creates a new IAM token, e.g. ycsdk.SDK.CreateIAMToken.
+type CreateFunc func(ctx context.Context) (*iam.CreateIamTokenResponse, error)
+
+// Source caches an IAM token and refreshes it before it expires. It is safe for concurrent use:
+// concurrent callers wait for a single token to be created instead of creating one each.
+type Source struct {
+	create        CreateFunc
+	refreshBefore time.Duration
+	now           func() time.Time
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+var shared = struct {
+	mu      sync.Mutex
+	sources map[string]*Source
+}{sources: make(map[string]*Source)}
+
+// NewSource returns a source that creates tokens with create. Unlike Shared, the source is not shared with other callers.
+func NewSource(create CreateFunc) *Source {
+	return &Source{
+		create:        create,
+		refreshBefore: DefaultRefreshBefore,
+		now:           time.Now,
+	}
+}
+
+// Token returns the cached token, or creates a new one if there is no token or it is about to expire.
+// Tokens without an expiration time are not cached.
+func (s *Source) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && s.now().Add(s.refreshBefore).Before(s.expiresAt) {
+		return s.token, nil
+	}
+
+	resp, err := s.create(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get IAM token: %w", err)
+	}
+
+	s.token = resp.GetIamToken()
+	s.expiresAt = time.Time{}
+	if resp.GetExpiresAt().IsValid() {
+		s.expiresAt = resp.GetExpiresAt().AsTime()
+	}
+	return s.token, nil
+}
+
+// CredentialsKey identifies the credentials of a provider configuration for Shared.
+// The key is a hash, so the credentials aren't kept in it.
+func CredentialsKey(endpoint, token, serviceAccountKey string) string {
+	sum := sha256.Sum256([]byte(endpoint + "\x00" + token + "\x00" + serviceAccountKey))
+	return hex.EncodeToString(sum[:])
+}
+
+// Shared returns the source of the credentials with the given key, the source is created with create on the first call.
+// The SDKv2 and the framework providers are served by one process with the same provider configuration,
+// so they share the token instead of creating one each.
+func Shared(key string, create CreateFunc) *Source {
+	shared.mu.Lock()
+	defer shared.mu.Unlock()
+
+	if s, ok := shared.sources[key]; ok {
+		return s
+	}
+	s := NewSource(create)
+	shared.sources[key] = s
+	return s
+}
@@ -0,0 +1,125 @@
+package iamtoken
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func testSource(now *time.Time, lifetime time.Duration, calls *int32) *Source {
+	s := NewSource(func(ctx context.Context) (*iam.CreateIamTokenResponse, error) {
+		n := atomic.AddInt32(calls, 1)
+		resp := &iam.CreateIamTokenResponse{IamToken: fmt.Sprintf("token-%d", n)}
+		if lifetime > 0 {
+			resp.ExpiresAt = timestamppb.New(now.Add(lifetime))
+		}
+		return resp, nil
+	})
+	s.now = func() time.Time { return *now }
+	return s
+}
+
+func TestSourceCachesToken(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var calls int32
+	s := testSource(&now, 12*time.Hour, &calls)
+
+	token, err := s.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+
+	now = now.Add(11 * time.Hour)
+	token, err = s.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+	assert.EqualValues(t, 1, calls)
+}
+
+func TestSourceRefreshesTokenEarly(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var calls int32
+	s := testSource(&now, 12*time.Hour, &calls)
+
+	_, err := s.Token(context.Background())
+	require.NoError(t, err)
+
+	now = now.Add(12*time.Hour - DefaultRefreshBefore)
+	token, err := s.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token)
+}
+
+func TestSourceDoesNotCacheTokenWithoutExpiration(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var calls int32
+	s := testSource(&now, 0, &calls)
+
+	_, err := s.Token(context.Background())
+	require.NoError(t, err)
+	token, err := s.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token)
+}
+
+func TestSourceError(t *testing.T) {
+	s := NewSource(func(ctx context.Context) (*iam.CreateIamTokenResponse, error) {
+		return nil, errors.New("throttled")
+	})
+
+	_, err := s.Token(context.Background())
+	assert.EqualError(t, err, "failed to get IAM token: throttled")
+}
+
+func TestSourceConcurrentCallers(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var calls int32
+	s := testSource(&now, 12*time.Hour, &calls)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := s.Token(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, "token-1", token)
+		}()
+	}
+	wg.Wait()
+	assert.EqualValues(t, 1, calls)
+}
+
+func TestSharedSource(t *testing.T) {
+	var calls int32
+	create := func(ctx context.Context) (*iam.CreateIamTokenResponse, error) {
+		n := atomic.AddInt32(&calls, 1)
+		return &iam.CreateIamTokenResponse{
+			IamToken:  fmt.Sprintf("token-%d", n),
+			ExpiresAt: timestamppb.New(time.Now().Add(12 * time.Hour)),
+		}, nil
+	}
+
+	key := CredentialsKey("api.cloud.yandex.net:443", "oauth-token", "")
+	first := Shared(key, create)
+	second := Shared(key, create)
+	assert.Same(t, first, second)
+
+	token, err := first.Token(context.Background())
+	require.NoError(t, err)
+	token2, err := second.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, token, token2)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	other := Shared(CredentialsKey("api.cloud.yandex.net:443", "other-token", ""), create)
+	assert.NotSame(t, first, other)
+}
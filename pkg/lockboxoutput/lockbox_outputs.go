@@ -0,0 +1,274 @@
+package lockboxoutput
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+)
+
+// Logic to store sensitive values of plugin-framework resources into Lockbox.
+// It is the framework counterpart of yandex/lockbox_outputs.go, used by SDKv2 resources.
+
+const (
+	Attr          = "output_to_lockbox"
+	VersionIDAttr = "output_to_lockbox_version_id"
+
+	secretIDAttr   = "secret_id"
+	entryKeyPrefix = "entry_for_"
+)
+
+// EntryAttr returns the name of the attribute (inside output_to_lockbox) that indicates the entry key for sensitiveAttr.
+func EntryAttr(sensitiveAttr string) string {
+	return entryKeyPrefix + sensitiveAttr
+}
+
+// AttrTypes returns the attribute types of the output_to_lockbox object for the given sensitive attributes.
+func AttrTypes(sensitiveAttrs []string) map[string]attr.Type {
+	attrTypes := map[string]attr.Type{
+		secretIDAttr: types.StringType,
+	}
+	for _, sensitiveAttr := range sensitiveAttrs {
+		attrTypes[EntryAttr(sensitiveAttr)] = types.StringType
+	}
+	return attrTypes
+}
+
+// NullValue returns a null output_to_lockbox object, e.g. to fill the state on import.
+func NullValue(sensitiveAttrs []string) types.Object {
+	return types.ObjectNull(AttrTypes(sensitiveAttrs))
+}
+
+// ExtendWithOutputToLockbox adds output_to_lockbox attributes, used by Manage and Destroy.
+// dependsOn lists other attributes whose change leads to a new Lockbox version (e.g. generate_password).
+func ExtendWithOutputToLockbox(attributes map[string]schema.Attribute, sensitiveAttrs []string, dependsOn ...string) map[string]schema.Attribute {
+	outputAttributes := map[string]schema.Attribute{
+		secretIDAttr: schema.StringAttribute{
+			MarkdownDescription: "ID of the Lockbox secret where to store the sensible values.",
+			Required:            true,
+		},
+	}
+
+	for _, sensitiveAttr := range sensitiveAttrs {
+		outputAttributes[EntryAttr(sensitiveAttr)] = schema.StringAttribute{
+			MarkdownDescription: "Entry that will store the value of `" + sensitiveAttr + "`.",
+			Required:            true,
+		}
+	}
+
+	attributes[Attr] = schema.SingleNestedAttribute{
+		MarkdownDescription: "Option to create a Lockbox secret version from sensitive values generated by the provider. The values are only stored in Lockbox and never get into the state, so they can't be set in the configuration.",
+		Optional:            true,
+		Attributes:          outputAttributes,
+		Validators: []validator.Object{
+			sensitiveValuesNotConfigured(sensitiveAttrs),
+		},
+	}
+
+	attributes[VersionIDAttr] = schema.StringAttribute{
+		MarkdownDescription: "ID of the Lockbox secret version that contains the sensitive values. This is only populated when `output_to_lockbox` is supplied. This version will be destroyed when the resource is destroyed, or when `output_to_lockbox` is removed.",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			useStateForUnknownIfUnchanged(append(append([]string{}, sensitiveAttrs...), dependsOn...)),
+		},
+	}
+
+	return attributes
+}
+
+// Manage keeps the Lockbox secret version in sync with the sensitive values of the resource.
+// If output_to_lockbox is removed: destroys the previous secret version.
+// If output_to_lockbox is added: stores the sensitive values into a new secret version.
+// If output_to_lockbox or any of the sensitive values is modified: a new version is added and the old one is destroyed.
+//
+// Values holds the current value of each sensitive attribute; priorValues holds the values stored in the state
+// before the operation (nil on Create). An empty value can't be stored, e.g. when the resource was imported.
+// Manage should be called at the end of the resource Create and Update methods,
+// and its result should be stored in the output_to_lockbox_version_id attribute. On error, the caller should keep
+// the prior output_to_lockbox in the state, so that it is not recorded as applied.
+//
+// Unlike yandex/lockbox_outputs.go, Manage doesn't clear the sensitive values from the state: Terraform requires
+// the state of plugin-framework resources to match the configuration. Instead, output_to_lockbox can't be set
+// together with configured sensitive values, so only the values generated by the provider (which are never written
+// to the state) are stored.
+func Manage(
+	ctx context.Context,
+	sdk *ycsdk.SDK,
+	sensitiveAttrs []string,
+	prior, planned types.Object,
+	priorVersionID types.String,
+	priorValues, values map[string]string,
+) (types.String, diag.Diagnostics) {
+	return manage(ctx, &sdkVersionStore{sdk: sdk}, sensitiveAttrs, prior, planned, priorVersionID, priorValues, values)
+}
+
+func manage(
+	ctx context.Context,
+	store versionStore,
+	sensitiveAttrs []string,
+	prior, planned types.Object,
+	priorVersionID types.String,
+	priorValues, values map[string]string,
+) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	priorSecretID, _ := attrString(prior, secretIDAttr)
+	priorVersion := priorVersionID.ValueString()
+
+	if planned.IsNull() || planned.IsUnknown() {
+		if priorSecretID != "" && priorVersion != "" {
+			tflog.Debug(ctx, fmt.Sprintf("output_to_lockbox was removed, destroying version %s/%s", priorSecretID, priorVersion))
+			diags.Append(destroyVersion(ctx, store, priorSecretID, priorVersion)...)
+		}
+		return types.StringNull(), diags
+	}
+
+	if prior.Equal(planned) && priorVersion != "" && equalValues(sensitiveAttrs, priorValues, values) {
+		tflog.Debug(ctx, "output_to_lockbox didn't change")
+		return priorVersionID, diags
+	}
+
+	secretID, _ := attrString(planned, secretIDAttr)
+	var entries []*lockbox.PayloadEntryChange
+	for _, sensitiveAttr := range sensitiveAttrs {
+		entryKey, ok := attrString(planned, EntryAttr(sensitiveAttr))
+		if !ok {
+			diags.AddError(
+				"Failed to store sensitive values in Lockbox",
+				fmt.Sprintf("entry key for sensitive attribute %q is not set in %s", sensitiveAttr, Attr),
+			)
+			return priorVersionID, diags
+		}
+		if values[sensitiveAttr] == "" {
+			diags.AddError(
+				"Failed to store sensitive values in Lockbox",
+				fmt.Sprintf("the value of sensitive attribute '%s' is unknown, probably because the resource was imported, so it can't be stored in Lockbox; recreate the resource to use %s", sensitiveAttr, Attr),
+			)
+			return priorVersionID, diags
+		}
+		tflog.Debug(ctx, fmt.Sprintf("sensitive attribute '%s' will be stored in entry key '%s'", sensitiveAttr, entryKey))
+		entries = append(entries, &lockbox.PayloadEntryChange{
+			Key:   entryKey,
+			Value: &lockbox.PayloadEntryChange_TextValue{TextValue: values[sensitiveAttr]},
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+
+	version, err := store.AddVersion(ctx, secretID, entries)
+	if err != nil {
+		diags.AddError(
+			"Failed to store sensitive values in Lockbox",
+			fmt.Sprintf("Error while adding version to Lockbox secret %s: %s", secretID, err),
+		)
+		return priorVersionID, diags
+	}
+	tflog.Debug(ctx, fmt.Sprintf("created version %s in secret %s", version.GetId(), secretID))
+
+	if priorSecretID != "" && priorVersion != "" {
+		diags.Append(destroyVersion(ctx, store, priorSecretID, priorVersion)...)
+	}
+
+	return types.StringValue(version.GetId()), diags
+}
+
+// Destroy destroys the Lockbox version if output_to_lockbox is being used. Should be called in the resource Delete method.
+func Destroy(ctx context.Context, sdk *ycsdk.SDK, output types.Object, versionID types.String) diag.Diagnostics {
+	secretID, _ := attrString(output, secretIDAttr)
+	if secretID == "" {
+		return nil // output_to_lockbox is not being used
+	}
+	if versionID.ValueString() == "" {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Failed to destroy Lockbox version",
+			fmt.Sprintf("unexpectedly, attribute %s is empty (this is probably a bug in the provider)", VersionIDAttr),
+		)
+		return diags
+	}
+	return destroyVersion(ctx, &sdkVersionStore{sdk: sdk}, secretID, versionID.ValueString())
+}
+
+func attrString(obj types.Object, name string) (string, bool) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return "", false
+	}
+	v, ok := obj.Attributes()[name].(types.String)
+	if !ok || v.IsNull() || v.IsUnknown() {
+		return "", false
+	}
+	return v.ValueString(), true
+}
+
+func equalValues(sensitiveAttrs []string, a, b map[string]string) bool {
+	for _, sensitiveAttr := range sensitiveAttrs {
+		if a[sensitiveAttr] != b[sensitiveAttr] {
+			return false
+		}
+	}
+	return true
+}
+
+// versionStore adds and destroys Lockbox secret versions; it is replaced with a fake in tests.
+type versionStore interface {
+	AddVersion(ctx context.Context, secretID string, entries []*lockbox.PayloadEntryChange) (*lockbox.Version, error)
+	ScheduleVersionDestruction(ctx context.Context, secretID, versionID string) error
+}
+
+type sdkVersionStore struct {
+	sdk *ycsdk.SDK
+}
+
+func (s *sdkVersionStore) AddVersion(ctx context.Context, secretID string, entries []*lockbox.PayloadEntryChange) (*lockbox.Version, error) {
+	op, err := s.sdk.WrapOperation(s.sdk.LockboxSecret().Secret().AddVersion(ctx, &lockbox.AddVersionRequest{
+		SecretId:       secretID,
+		PayloadEntries: entries,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if err = op.Wait(ctx); err != nil {
+		return nil, err
+	}
+	resp, err := op.Response()
+	if err != nil {
+		return nil, err
+	}
+	version, ok := resp.(*lockbox.Version)
+	if !ok {
+		return nil, fmt.Errorf("unexpected operation response type %T", resp)
+	}
+	return version, nil
+}
+
+func (s *sdkVersionStore) ScheduleVersionDestruction(ctx context.Context, secretID, versionID string) error {
+	op, err := s.sdk.WrapOperation(s.sdk.LockboxSecret().Secret().ScheduleVersionDestruction(ctx, &lockbox.ScheduleVersionDestructionRequest{
+		SecretId:  secretID,
+		VersionId: versionID,
+	}))
+	if err != nil {
+		return err
+	}
+	return op.Wait(ctx)
+}
+
+func destroyVersion(ctx context.Context, store versionStore, secretID, versionID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, fmt.Sprintf("scheduling destruction of Lockbox version %s/%s", secretID, versionID))
+	if err := store.ScheduleVersionDestruction(ctx, secretID, versionID); err != nil {
+		diags.AddError(
+			"Failed to destroy Lockbox version",
+			fmt.Sprintf("Error while scheduling destruction of version %s of Lockbox secret %s: %s", versionID, secretID, err),
+		)
+	}
+	return diags
+}
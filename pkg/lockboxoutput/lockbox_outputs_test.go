@@ -0,0 +1,221 @@
+package lockboxoutput
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+)
+
+func testOutputValue(t *testing.T, secretID, entryKey string) types.Object {
+	v, diags := types.ObjectValue(AttrTypes([]string{"password"}), map[string]attr.Value{
+		"secret_id":          types.StringValue(secretID),
+		"entry_for_password": types.StringValue(entryKey),
+	})
+	if diags.HasError() {
+		t.Fatalf("failed to build output_to_lockbox value: %v", diags)
+	}
+	return v
+}
+
+func TestExtendWithOutputToLockbox(t *testing.T) {
+	attributes := ExtendWithOutputToLockbox(map[string]schema.Attribute{
+		"password": schema.StringAttribute{Optional: true, Sensitive: true},
+	}, []string{"password"})
+
+	output, ok := attributes[Attr].(schema.SingleNestedAttribute)
+	if !ok {
+		t.Fatalf("expected %s to be a single nested attribute, got %T", Attr, attributes[Attr])
+	}
+	for _, name := range []string{"secret_id", "entry_for_password"} {
+		if _, ok := output.Attributes[name]; !ok {
+			t.Errorf("expected %s to have attribute %s", Attr, name)
+		}
+	}
+
+	versionID, ok := attributes[VersionIDAttr].(schema.StringAttribute)
+	if !ok || !versionID.Computed {
+		t.Errorf("expected %s to be a computed string attribute", VersionIDAttr)
+	}
+
+	if !output.GetType().Equal(types.ObjectType{AttrTypes: AttrTypes([]string{"password"})}) {
+		t.Errorf("unexpected %s type: %s", Attr, output.GetType())
+	}
+}
+
+// fakeVersionStore records the Lockbox calls made by manage.
+type fakeVersionStore struct {
+	added     [][]*lockbox.PayloadEntryChange
+	destroyed []string
+	addErr    error
+}
+
+func (s *fakeVersionStore) AddVersion(_ context.Context, secretID string, entries []*lockbox.PayloadEntryChange) (*lockbox.Version, error) {
+	if s.addErr != nil {
+		return nil, s.addErr
+	}
+	s.added = append(s.added, entries)
+	return &lockbox.Version{Id: fmt.Sprintf("version-%d", len(s.added)), SecretId: secretID}, nil
+}
+
+func (s *fakeVersionStore) ScheduleVersionDestruction(_ context.Context, secretID, versionID string) error {
+	s.destroyed = append(s.destroyed, secretID+"/"+versionID)
+	return nil
+}
+
+func TestManageWithoutChanges(t *testing.T) {
+	ctx := context.Background()
+	sensitiveAttrs := []string{"password"}
+	store := &fakeVersionStore{}
+
+	versionID, diags := manage(ctx, store, sensitiveAttrs,
+		NullValue(sensitiveAttrs), NullValue(sensitiveAttrs), types.StringNull(),
+		nil, map[string]string{"password": "secret"},
+	)
+	if diags.HasError() || !versionID.IsNull() {
+		t.Errorf("expected null version when output_to_lockbox is not used, got %s, %v", versionID, diags)
+	}
+
+	output := testOutputValue(t, "secret-id", "key")
+	versionID, diags = manage(ctx, store, sensitiveAttrs,
+		output, output, types.StringValue("version-id"),
+		map[string]string{"password": "secret"}, map[string]string{"password": "secret"},
+	)
+	if diags.HasError() || versionID.ValueString() != "version-id" {
+		t.Errorf("expected prior version to be kept, got %s, %v", versionID, diags)
+	}
+
+	if len(store.added) != 0 || len(store.destroyed) != 0 {
+		t.Errorf("expected no Lockbox calls, got added %v, destroyed %v", store.added, store.destroyed)
+	}
+}
+
+func TestManageAddOutput(t *testing.T) {
+	sensitiveAttrs := []string{"password"}
+	store := &fakeVersionStore{}
+
+	versionID, diags := manage(context.Background(), store, sensitiveAttrs,
+		NullValue(sensitiveAttrs), testOutputValue(t, "secret-id", "key"), types.StringNull(),
+		nil, map[string]string{"password": "secret"},
+	)
+	if diags.HasError() || versionID.ValueString() != "version-1" {
+		t.Fatalf("expected new version, got %s, %v", versionID, diags)
+	}
+	if len(store.added) != 1 || len(store.added[0]) != 1 {
+		t.Fatalf("expected one version with one entry, got %v", store.added)
+	}
+	if entry := store.added[0][0]; entry.GetKey() != "key" || entry.GetTextValue() != "secret" {
+		t.Errorf("unexpected entry: %v", entry)
+	}
+	if len(store.destroyed) != 0 {
+		t.Errorf("expected no version to be destroyed, got %v", store.destroyed)
+	}
+}
+
+func TestManageReplaceVersion(t *testing.T) {
+	sensitiveAttrs := []string{"password"}
+	output := testOutputValue(t, "secret-id", "key")
+
+	for name, planned := range map[string]types.Object{
+		"value changed":  output,
+		"output changed": testOutputValue(t, "other-secret-id", "other-key"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			store := &fakeVersionStore{}
+			values := map[string]string{"password": "secret"}
+			if planned.Equal(output) {
+				values["password"] = "new-secret"
+			}
+
+			versionID, diags := manage(context.Background(), store, sensitiveAttrs,
+				output, planned, types.StringValue("old-version"),
+				map[string]string{"password": "secret"}, values,
+			)
+			if diags.HasError() || versionID.ValueString() != "version-1" {
+				t.Fatalf("expected new version, got %s, %v", versionID, diags)
+			}
+			if len(store.added) != 1 || store.added[0][0].GetTextValue() != values["password"] {
+				t.Errorf("expected the new value to be stored, got %v", store.added)
+			}
+			if len(store.destroyed) != 1 || store.destroyed[0] != "secret-id/old-version" {
+				t.Errorf("expected the old version to be destroyed, got %v", store.destroyed)
+			}
+		})
+	}
+}
+
+func TestManageRemoveOutput(t *testing.T) {
+	sensitiveAttrs := []string{"password"}
+	store := &fakeVersionStore{}
+
+	versionID, diags := manage(context.Background(), store, sensitiveAttrs,
+		testOutputValue(t, "secret-id", "key"), NullValue(sensitiveAttrs), types.StringValue("old-version"),
+		map[string]string{"password": "secret"}, map[string]string{"password": "secret"},
+	)
+	if diags.HasError() || !versionID.IsNull() {
+		t.Fatalf("expected null version, got %s, %v", versionID, diags)
+	}
+	if len(store.added) != 0 {
+		t.Errorf("expected no version to be added, got %v", store.added)
+	}
+	if len(store.destroyed) != 1 || store.destroyed[0] != "secret-id/old-version" {
+		t.Errorf("expected the old version to be destroyed, got %v", store.destroyed)
+	}
+}
+
+func TestManageUnknownValue(t *testing.T) {
+	sensitiveAttrs := []string{"password"}
+	store := &fakeVersionStore{}
+
+	// e.g. an imported user
+	versionID, diags := manage(context.Background(), store, sensitiveAttrs,
+		NullValue(sensitiveAttrs), testOutputValue(t, "secret-id", "key"), types.StringNull(),
+		map[string]string{"password": ""}, map[string]string{"password": ""},
+	)
+	if !diags.HasError() {
+		t.Fatalf("expected an error for an unknown value")
+	}
+	if !strings.Contains(diags.Errors()[0].Detail(), "the value of sensitive attribute 'password' is unknown") {
+		t.Errorf("unexpected error: %v", diags)
+	}
+	if !versionID.IsNull() || len(store.added) != 0 || len(store.destroyed) != 0 {
+		t.Errorf("expected no Lockbox calls, got version %s, added %v, destroyed %v", versionID, store.added, store.destroyed)
+	}
+}
+
+func TestManageAddVersionError(t *testing.T) {
+	sensitiveAttrs := []string{"password"}
+	output := testOutputValue(t, "secret-id", "key")
+	store := &fakeVersionStore{addErr: errors.New("permission denied")}
+
+	versionID, diags := manage(context.Background(), store, sensitiveAttrs,
+		output, output, types.StringValue("old-version"),
+		map[string]string{"password": "secret"}, map[string]string{"password": "new-secret"},
+	)
+	if !diags.HasError() {
+		t.Fatalf("expected an error")
+	}
+	if versionID.ValueString() != "old-version" || len(store.destroyed) != 0 {
+		t.Errorf("expected the old version to be kept, got %s, destroyed %v", versionID, store.destroyed)
+	}
+}
+
+func TestAttrString(t *testing.T) {
+	output := testOutputValue(t, "secret-id", "key")
+
+	if v, ok := attrString(output, "secret_id"); !ok || v != "secret-id" {
+		t.Errorf("unexpected secret_id: %q, %t", v, ok)
+	}
+	if _, ok := attrString(output, "unknown"); ok {
+		t.Errorf("expected missing attribute not to be found")
+	}
+	if _, ok := attrString(NullValue([]string{"password"}), "secret_id"); ok {
+		t.Errorf("expected null object to have no attributes")
+	}
+}
@@ -0,0 +1,53 @@
+package lockboxoutput
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// useStateForUnknownIfUnchanged keeps output_to_lockbox_version_id from the state
+// while neither output_to_lockbox nor the given attributes are changed,
+// since a new Lockbox version is only added in that case.
+func useStateForUnknownIfUnchanged(attrs []string) planmodifier.String {
+	return versionIDModifier{attrs: attrs}
+}
+
+type versionIDModifier struct {
+	attrs []string
+}
+
+func (m versionIDModifier) Description(_ context.Context) string {
+	return "Uses the prior state value unless output_to_lockbox or the attributes it depends on are changed."
+}
+
+func (m versionIDModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m versionIDModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing on resource creation or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, name := range append([]string{Attr}, m.attrs...) {
+		var planned, prior attr.Value
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), &planned)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(name), &prior)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !planned.Equal(prior) {
+			return
+		}
+	}
+
+	resp.PlanValue = req.StateValue
+}
@@ -0,0 +1,52 @@
+package lockboxoutput
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// sensitiveValuesNotConfigured rejects output_to_lockbox when any of the sensitive attributes is set
+// in the configuration: Terraform keeps configured values in the state, so they can't be kept out of it.
+func sensitiveValuesNotConfigured(sensitiveAttrs []string) validator.Object {
+	return sensitiveValuesValidator{sensitiveAttrs: sensitiveAttrs}
+}
+
+type sensitiveValuesValidator struct {
+	sensitiveAttrs []string
+}
+
+func (v sensitiveValuesValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("%s can't be set in the configuration together with %s", strings.Join(v.sensitiveAttrs, ", "), Attr)
+}
+
+func (v sensitiveValuesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v sensitiveValuesValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() {
+		return
+	}
+
+	for _, sensitiveAttr := range v.sensitiveAttrs {
+		var value attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(sensitiveAttr), &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if value.IsNull() {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid output_to_lockbox configuration",
+			fmt.Sprintf("%s is set in the configuration, so it would be kept in the state anyway; "+
+				"remove it and let the provider generate the value to store it only in Lockbox", sensitiveAttr),
+		)
+	}
+}
@@ -0,0 +1,87 @@
+package lockboxoutput
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSensitiveValuesNotConfigured(t *testing.T) {
+	ctx := context.Background()
+	sensitiveAttrs := []string{"password"}
+	testSchema := schema.Schema{
+		Attributes: ExtendWithOutputToLockbox(map[string]schema.Attribute{
+			"password":          schema.StringAttribute{Optional: true, Sensitive: true},
+			"generate_password": schema.BoolAttribute{Optional: true},
+		}, sensitiveAttrs),
+	}
+	schemaType := testSchema.Type().TerraformType(ctx).(tftypes.Object)
+	outputType := schemaType.AttributeTypes[Attr].(tftypes.Object)
+
+	output := tftypes.NewValue(outputType, map[string]tftypes.Value{
+		"secret_id":          tftypes.NewValue(tftypes.String, "secret-id"),
+		"entry_for_password": tftypes.NewValue(tftypes.String, "key"),
+	})
+
+	cases := []struct {
+		name      string
+		password  tftypes.Value
+		output    tftypes.Value
+		expectErr bool
+	}{
+		{
+			name:     "generated password",
+			password: tftypes.NewValue(tftypes.String, nil),
+			output:   output,
+		},
+		{
+			name:     "configured password without output",
+			password: tftypes.NewValue(tftypes.String, "secret"),
+			output:   tftypes.NewValue(outputType, nil),
+		},
+		{
+			name:      "configured password with output",
+			password:  tftypes.NewValue(tftypes.String, "secret"),
+			output:    output,
+			expectErr: true,
+		},
+		{
+			name:      "unknown password with output",
+			password:  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			output:    output,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := tfsdk.Config{
+				Schema: testSchema,
+				Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+					"password":          tc.password,
+					"generate_password": tftypes.NewValue(tftypes.Bool, tc.password.IsNull()),
+					Attr:                tc.output,
+					VersionIDAttr:       tftypes.NewValue(tftypes.String, nil),
+				}),
+			}
+			req := validator.ObjectRequest{
+				Path:   path.Root(Attr),
+				Config: config,
+			}
+			if diags := config.GetAttribute(ctx, path.Root(Attr), &req.ConfigValue); diags.HasError() {
+				t.Fatalf("failed to read %s: %v", Attr, diags)
+			}
+
+			resp := &validator.ObjectResponse{}
+			sensitiveValuesNotConfigured(sensitiveAttrs).ValidateObject(ctx, req, resp)
+			if resp.Diagnostics.HasError() != tc.expectErr {
+				t.Errorf("expected error: %t, got: %v", tc.expectErr, resp.Diagnostics)
+			}
+		})
+	}
+}
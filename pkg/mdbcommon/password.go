@@ -0,0 +1,102 @@
+package mdbcommon
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/lockboxoutput"
+)
+
+const (
+	generatedPasswordLength  = 32
+	generatedPasswordCharset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+// GeneratePassword returns a random password for users of services whose API can't generate one.
+func GeneratePassword() (string, error) {
+	password := make([]byte, generatedPasswordLength)
+	max := big.NewInt(int64(len(generatedPasswordCharset)))
+	for i := range password {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate password: %w", err)
+		}
+		password[i] = generatedPasswordCharset[n.Int64()]
+	}
+	return string(password), nil
+}
+
+// GeneratePasswordAttribute returns the generate_password attribute for users whose password is generated by the provider
+// and stored only into Lockbox, passwordAttr is the name of the attribute that holds the password otherwise.
+func GeneratePasswordAttribute(passwordAttr string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Generate a random password and store it only into the Lockbox secret set in `output_to_lockbox`, so that it never gets into the Terraform state. " +
+			"The password is generated again when `output_to_lockbox` is changed.\n\n" +
+			"~> **Must specify either " + passwordAttr + " or generate_password**.\n",
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Validators: []validator.Bool{
+			generatePasswordValidator{passwordAttr: passwordAttr},
+		},
+	}
+}
+
+// GeneratedPassword returns a new random password when generate_password is being enabled,
+// or output_to_lockbox is changed while it's enabled; otherwise it returns an empty string.
+// Prior values are null when the user is being created.
+func GeneratedPassword(priorGenerate, generate types.Bool, priorOutput, output types.Object, diags *diag.Diagnostics) string {
+	if !generate.ValueBool() || (priorGenerate.ValueBool() && priorOutput.Equal(output)) {
+		return ""
+	}
+
+	password, err := GeneratePassword()
+	if err != nil {
+		diags.AddError("Failed to generate password", err.Error())
+		return ""
+	}
+	return password
+}
+
+type generatePasswordValidator struct {
+	passwordAttr string
+}
+
+func (v generatePasswordValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Exactly one of %s or generate_password must be set; if generate_password is true, %s must be set as well", v.passwordAttr, lockboxoutput.Attr)
+}
+
+func (v generatePasswordValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateBool checks the password settings of the user: generate_password is false when it's not set.
+func (v generatePasswordValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var password, output attr.Value
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(v.passwordAttr), &password)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(lockboxoutput.Attr), &output)...)
+	if resp.Diagnostics.HasError() || password.IsUnknown() || output.IsUnknown() {
+		return
+	}
+
+	if req.ConfigValue.ValueBool() == !password.IsNull() || (req.ConfigValue.ValueBool() && output.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid user configuration",
+			v.Description(ctx),
+		)
+	}
+}
@@ -0,0 +1,184 @@
+package mdbcommon
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/lockboxoutput"
+)
+
+func TestGeneratePassword(t *testing.T) {
+	password, err := GeneratePassword()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(password) != generatedPasswordLength {
+		t.Errorf("expected password of length %d, got %d", generatedPasswordLength, len(password))
+	}
+	for _, c := range password {
+		if !strings.ContainsRune(generatedPasswordCharset, c) {
+			t.Errorf("unexpected character %q in password", c)
+		}
+	}
+
+	other, _ := GeneratePassword()
+	if password == other {
+		t.Errorf("expected different passwords, got %q twice", password)
+	}
+}
+
+func TestGeneratedPassword(t *testing.T) {
+	attrTypes := map[string]attr.Type{"secret_id": types.StringType}
+	output := func(secretID string) types.Object {
+		return types.ObjectValueMust(attrTypes, map[string]attr.Value{"secret_id": types.StringValue(secretID)})
+	}
+
+	cases := []struct {
+		name          string
+		priorGenerate types.Bool
+		generate      types.Bool
+		priorOutput   types.Object
+		output        types.Object
+		expected      bool
+	}{
+		{
+			name:          "create without generate_password",
+			priorGenerate: types.BoolNull(),
+			generate:      types.BoolValue(false),
+			priorOutput:   types.ObjectNull(attrTypes),
+			output:        output("secret"),
+			expected:      false,
+		},
+		{
+			name:          "create with generate_password",
+			priorGenerate: types.BoolNull(),
+			generate:      types.BoolValue(true),
+			priorOutput:   types.ObjectNull(attrTypes),
+			output:        output("secret"),
+			expected:      true,
+		},
+		{
+			name:          "generate_password enabled",
+			priorGenerate: types.BoolValue(false),
+			generate:      types.BoolValue(true),
+			priorOutput:   output("secret"),
+			output:        output("secret"),
+			expected:      true,
+		},
+		{
+			name:          "nothing changed",
+			priorGenerate: types.BoolValue(true),
+			generate:      types.BoolValue(true),
+			priorOutput:   output("secret"),
+			output:        output("secret"),
+			expected:      false,
+		},
+		{
+			name:          "output_to_lockbox changed",
+			priorGenerate: types.BoolValue(true),
+			generate:      types.BoolValue(true),
+			priorOutput:   output("secret"),
+			output:        output("other"),
+			expected:      true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			password := GeneratedPassword(c.priorGenerate, c.generate, c.priorOutput, c.output, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if (password != "") != c.expected {
+				t.Errorf("expected password to be generated: %t, got %q", c.expected, password)
+			}
+		})
+	}
+}
+
+// buildTestGeneratePasswordRequest builds the request for the generate_password validator,
+// password and secretID are nil when they are not set in the configuration.
+func buildTestGeneratePasswordRequest(generate types.Bool, password, secretID interface{}) validator.BoolRequest {
+	outputType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"secret_id":          tftypes.String,
+		"entry_for_password": tftypes.String,
+	}}
+	output := tftypes.NewValue(outputType, nil)
+	if secretID != nil {
+		output = tftypes.NewValue(outputType, map[string]tftypes.Value{
+			"secret_id":          tftypes.NewValue(tftypes.String, secretID),
+			"entry_for_password": tftypes.NewValue(tftypes.String, "password"),
+		})
+	}
+
+	var rawGenerate interface{}
+	if !generate.IsNull() {
+		rawGenerate = generate.ValueBool()
+	}
+
+	return validator.BoolRequest{
+		Config: tfsdk.Config{
+			Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"password":                     tftypes.String,
+				"generate_password":            tftypes.Bool,
+				"output_to_lockbox":            outputType,
+				"output_to_lockbox_version_id": tftypes.String,
+			}}, map[string]tftypes.Value{
+				"password":                     tftypes.NewValue(tftypes.String, password),
+				"generate_password":            tftypes.NewValue(tftypes.Bool, rawGenerate),
+				"output_to_lockbox":            output,
+				"output_to_lockbox_version_id": tftypes.NewValue(tftypes.String, nil),
+			}),
+			Schema: schema.Schema{
+				Attributes: lockboxoutput.ExtendWithOutputToLockbox(map[string]schema.Attribute{
+					"password":          schema.StringAttribute{Optional: true, Sensitive: true},
+					"generate_password": GeneratePasswordAttribute("password"),
+				}, []string{"password"}),
+			},
+		},
+		ConfigValue: generate,
+		Path:        path.Root("generate_password"),
+	}
+}
+
+func TestGeneratePasswordValidator(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cases := []struct {
+		testname      string
+		generate      types.Bool
+		password      interface{}
+		secretID      interface{}
+		expectedError bool
+	}{
+		{testname: "password", generate: types.BoolNull(), password: "secret"},
+		{testname: "password with generate_password = false", generate: types.BoolValue(false), password: "secret"},
+		{testname: "generate_password", generate: types.BoolValue(true), secretID: "secret-id"},
+		{testname: "neither password nor generate_password", generate: types.BoolNull(), expectedError: true},
+		{testname: "generate_password = false without password", generate: types.BoolValue(false), expectedError: true},
+		{testname: "both password and generate_password", generate: types.BoolValue(true), password: "secret", secretID: "secret-id", expectedError: true},
+		{testname: "generate_password without output_to_lockbox", generate: types.BoolValue(true), expectedError: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testname, func(t *testing.T) {
+			req := buildTestGeneratePasswordRequest(c.generate, c.password, c.secretID)
+			resp := &validator.BoolResponse{}
+			generatePasswordValidator{passwordAttr: "password"}.ValidateBool(ctx, req, resp)
+			if resp.Diagnostics.HasError() != c.expectedError {
+				t.Errorf("expected error: %t, got diagnostics: %v", c.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
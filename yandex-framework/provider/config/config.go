@@ -29,6 +29,7 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/config"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/iamtoken"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/yqsdk"
 )
@@ -69,16 +70,6 @@ type State struct {
 	//sharedCredentials *SharedCredentials
 }
 
-// TODO: remove yandex.Config when it is not used
-type iamToken struct {
-	Token     string
-	expiresAt time.Time
-}
-
-func (t iamToken) IsValid() bool {
-	return t.Token != "" && t.expiresAt.After(time.Now())
-}
-
 type Config struct {
 	ProviderState State
 
@@ -86,7 +77,8 @@ type Config struct {
 	SDK       *ycsdk.SDK
 	SDKv2     *ycsdkv2.SDK
 	YqSdk     *yqsdk.SDK
-	iamToken  *iamToken
+
+	iamTokenSource *iamtoken.Source
 
 	defaultS3Client *s3.Client
 }
@@ -151,6 +143,12 @@ func (c *Config) InitAndValidate(ctx context.Context, terraformVersion string, s
 	if err != nil {
 		return err
 	}
+	// the SDKv2 provider is configured with the same credentials and shares the token
+	c.iamTokenSource = iamtoken.Shared(iamtoken.CredentialsKey(
+		c.ProviderState.Endpoint.ValueString(),
+		c.ProviderState.Token.ValueString(),
+		c.ProviderState.ServiceAccountKeyFileOrContent.ValueString(),
+	), c.SDK.CreateIAMToken)
 
 	opts := []options.Option{
 		options.WithCredentials(credentialsV2),
@@ -169,7 +167,7 @@ func (c *Config) InitAndValidate(ctx context.Context, terraformVersion string, s
 	}
 
 	yqSDKConfig := &yqsdk.Config{
-		AuthTokenProvider: func(ctx context.Context) (string, error) { return c.getIAMToken(ctx) },
+		AuthTokenProvider: c.getIAMToken,
 		FolderID:          c.ProviderState.FolderID.ValueString(),
 		TLSConfig: &tls.Config{
 			InsecureSkipVerify: c.ProviderState.Insecure.ValueBool(),
@@ -191,13 +189,14 @@ func (c *Config) initializeDefaultS3Client(ctx context.Context) (err error) {
 	secretKey := c.ProviderState.StorageSecretKey.ValueString()
 
 	// accessKey, secretKey := c.resolveStorageAccessKeys()
-	iamToken, err := c.getIAMToken(ctx)
-	if err != nil {
+	var iamToken s3.IAMTokenFunc
+	if _, err := c.getIAMToken(ctx); err != nil {
 		log.Println("[WARN] Failed to get IAM token for default storage client:", err)
-		iamToken = ""
+	} else {
+		iamToken = c.getIAMToken
 	}
 
-	if (accessKey == "" || secretKey == "") && iamToken == "" {
+	if (accessKey == "" || secretKey == "") && iamToken == nil {
 		return nil
 	}
 
@@ -215,7 +214,7 @@ func (c *Config) GetS3Client(ctx context.Context, accessKey, secretKey string) (
 
 	// iamToken is not needed here, since we cannot specify it in the resource.
 	// Otherwise, defaultS3Client must be initialised.
-	return s3.NewClient(ctx, accessKey, secretKey, "", c.ProviderState.StorageEndpoint.ValueString())
+	return s3.NewClient(ctx, accessKey, secretKey, nil, c.ProviderState.StorageEndpoint.ValueString())
 }
 
 func (c *Config) Credentials(ctx context.Context) (ycsdk.Credentials, error) {
@@ -286,24 +285,14 @@ func (c *Config) CredentialsV2(ctx context.Context) (credentials.Credentials, er
 		"authenticate via instance service account")
 }
 
+// getIAMToken returns the IAM token of the provider credentials. The token is cached and shared by
+// the clients that can't use the SDK credentials, such as the YQ and storage clients.
 func (c *Config) getIAMToken(ctx context.Context) (string, error) {
-	if c.iamToken != nil && c.iamToken.IsValid() {
-		return c.iamToken.Token, nil
+	// the source is created once in InitAndValidate, so that concurrent operations share it
+	if c.iamTokenSource == nil {
+		return "", fmt.Errorf("IAM token source is not initialized")
 	}
-
-	resp, err := c.SDK.CreateIAMToken(ctx)
-	if err != nil {
-		return "", fmt.Errorf("failed to get IAM token: %w", err)
-	}
-
-	c.iamToken = &iamToken{
-		Token: resp.IamToken,
-	}
-	if resp.ExpiresAt != nil && resp.ExpiresAt.IsValid() {
-		c.iamToken.expiresAt = resp.ExpiresAt.AsTime()
-	}
-
-	return c.iamToken.Token, nil
+	return c.iamTokenSource.Token(ctx)
 }
 
 func iamKeyFromJSONContent(content string) (*iamkey.Key, error) {
@@ -20,6 +20,7 @@ import (
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/airflow_cluster"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/billing_cloud_binding"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/cloudinit_config"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/cloudregistry_ip_permission"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/datasphere_community"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/datasphere_project"
@@ -338,6 +339,10 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		mdb_redis_cluster_v2.NewDataSource,
 		mdb_redis_user.NewDataSource,
 		mdb_opensearch_cluster.NewDataSource,
+		mdb_sharded_postgresql_cluster.NewShardedPostgreSQLClusterDataSource,
+		mdb_sharded_postgresql_user.NewShardedPostgreSQLUserDataSource,
+		mdb_sharded_postgresql_database.NewShardedPostgreSQLDatabaseDataSource,
+		mdb_sharded_postgresql_shard.NewShardedPostgreSQLShardDataSource,
 		vpc_security_group_rule.NewDataSource,
 		spark_cluster.NewDatasource,
 		gitlab_instance.NewDataSource,
@@ -345,6 +350,7 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		trino_cluster.NewDatasource,
 		trino_catalog.NewDatasource,
 		cloudregistry_ip_permission.NewDataSource,
+		cloudinit_config.NewDataSource,
 	}, yandex_gen.GetProviderDataSources()...)
 }
 
@@ -0,0 +1,329 @@
+package cloudinit_config
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+const (
+	userDataMetadataKey      = "user-data"
+	enableOsLoginMetadataKey = "enable-oslogin"
+)
+
+type cloudInitConfigModel struct {
+	ID                types.String `tfsdk:"id"`
+	Users             types.List   `tfsdk:"users"`
+	SSHAuthorizedKeys types.List   `tfsdk:"ssh_authorized_keys"`
+	PackageUpdate     types.Bool   `tfsdk:"package_update"`
+	PackageUpgrade    types.Bool   `tfsdk:"package_upgrade"`
+	Packages          types.List   `tfsdk:"packages"`
+	WriteFiles        types.List   `tfsdk:"write_files"`
+	Runcmd            types.List   `tfsdk:"runcmd"`
+	CloudConfig       types.String `tfsdk:"cloud_config"`
+	Parts             types.List   `tfsdk:"parts"`
+	Gzip              types.Bool   `tfsdk:"gzip"`
+	Base64Encode      types.Bool   `tfsdk:"base64_encode"`
+	EnableOsLogin     types.Bool   `tfsdk:"enable_oslogin"`
+	Rendered          types.String `tfsdk:"rendered"`
+	Metadata          types.Map    `tfsdk:"metadata"`
+}
+
+type userModel struct {
+	Name              types.String `tfsdk:"name"`
+	Gecos             types.String `tfsdk:"gecos"`
+	Groups            types.List   `tfsdk:"groups"`
+	Sudo              types.String `tfsdk:"sudo"`
+	Shell             types.String `tfsdk:"shell"`
+	LockPasswd        types.Bool   `tfsdk:"lock_passwd"`
+	SSHAuthorizedKeys types.List   `tfsdk:"ssh_authorized_keys"`
+}
+
+type writeFileModel struct {
+	Path        types.String `tfsdk:"path"`
+	Content     types.String `tfsdk:"content"`
+	Permissions types.String `tfsdk:"permissions"`
+	Owner       types.String `tfsdk:"owner"`
+	Append      types.Bool   `tfsdk:"append"`
+}
+
+type partModel struct {
+	ContentType types.String `tfsdk:"content_type"`
+	Content     types.String `tfsdk:"content"`
+	Filename    types.String `tfsdk:"filename"`
+	MergeType   types.String `tfsdk:"merge_type"`
+}
+
+type cloudInitConfigDataSource struct{}
+
+func NewDataSource() datasource.DataSource {
+	return &cloudInitConfigDataSource{}
+}
+
+func (d *cloudInitConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudinit_config"
+}
+
+func (d *cloudInitConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Renders [cloud-init](https://cloudinit.readthedocs.io/) user data for `yandex_compute_instance` and `yandex_compute_instance_group` metadata. " +
+			"Typed attributes are validated and rendered into a `#cloud-config` document; if `parts` are specified, a multi-part MIME message is rendered instead. " +
+			"The data source does not call the Yandex Cloud API.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["id"],
+				Computed:            true,
+			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "Users to create. If set, the default user of the image is not created unless `cloud_config` adds it.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the user.",
+							Required:            true,
+						},
+						"gecos": schema.StringAttribute{
+							MarkdownDescription: "Full name of the user.",
+							Optional:            true,
+						},
+						"groups": schema.ListAttribute{
+							MarkdownDescription: "Supplementary groups of the user.",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+						"sudo": schema.StringAttribute{
+							MarkdownDescription: "Sudo rule of the user, e.g. `ALL=(ALL) NOPASSWD:ALL`.",
+							Optional:            true,
+						},
+						"shell": schema.StringAttribute{
+							MarkdownDescription: "Login shell of the user.",
+							Optional:            true,
+						},
+						"lock_passwd": schema.BoolAttribute{
+							MarkdownDescription: "Disable password login for the user.",
+							Optional:            true,
+						},
+						"ssh_authorized_keys": schema.ListAttribute{
+							MarkdownDescription: "SSH public keys of the user.",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"ssh_authorized_keys": schema.ListAttribute{
+				MarkdownDescription: "SSH public keys of the default user.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"package_update": schema.BoolAttribute{
+				MarkdownDescription: "Update the package database on first boot.",
+				Optional:            true,
+			},
+			"package_upgrade": schema.BoolAttribute{
+				MarkdownDescription: "Upgrade installed packages on first boot.",
+				Optional:            true,
+			},
+			"packages": schema.ListAttribute{
+				MarkdownDescription: "Packages to install on first boot.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"write_files": schema.ListNestedAttribute{
+				MarkdownDescription: "Files to write on first boot.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Absolute path of the file.",
+							Required:            true,
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "Content of the file.",
+							Required:            true,
+						},
+						"permissions": schema.StringAttribute{
+							MarkdownDescription: "Octal mode of the file, e.g. `0644`.",
+							Optional:            true,
+						},
+						"owner": schema.StringAttribute{
+							MarkdownDescription: "Owner of the file in the `user:group` format.",
+							Optional:            true,
+						},
+						"append": schema.BoolAttribute{
+							MarkdownDescription: "Append the content to the file instead of overwriting it.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"runcmd": schema.ListAttribute{
+				MarkdownDescription: "Shell commands to run on first boot.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"cloud_config": schema.StringAttribute{
+				MarkdownDescription: "Additional `#cloud-config` document in YAML, merged into the rendered one. " +
+					"Keys that are also set by the typed attributes are not allowed; unknown keys produce a warning.",
+				Optional: true,
+			},
+			"parts": schema.ListNestedAttribute{
+				MarkdownDescription: "Additional parts of a multi-part MIME message, e.g. shell scripts. The rendered `#cloud-config` document, if any, is the first part.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"content_type": schema.StringAttribute{
+							MarkdownDescription: "MIME type of the part, e.g. `text/x-shellscript`.",
+							Required:            true,
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "Content of the part.",
+							Required:            true,
+						},
+						"filename": schema.StringAttribute{
+							MarkdownDescription: "Filename of the part.",
+							Optional:            true,
+						},
+						"merge_type": schema.StringAttribute{
+							MarkdownDescription: "Value of the `X-Merge-Type` header, which controls how cloud-init merges the part.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"gzip": schema.BoolAttribute{
+				MarkdownDescription: "Compress the rendered user data with gzip. Requires `base64_encode`.",
+				Optional:            true,
+			},
+			"base64_encode": schema.BoolAttribute{
+				MarkdownDescription: "Encode the rendered user data with base64.",
+				Optional:            true,
+			},
+			"enable_oslogin": schema.BoolAttribute{
+				MarkdownDescription: "Add `enable-oslogin` to `metadata`. Use it together with the `yandex_organizationmanager_os_login_settings` data source to follow the organization OS Login settings.",
+				Optional:            true,
+			},
+			"rendered": schema.StringAttribute{
+				MarkdownDescription: "The rendered user data.",
+				Computed:            true,
+			},
+			"metadata": schema.MapAttribute{
+				MarkdownDescription: "Metadata to be passed to `yandex_compute_instance.metadata` or `instance_template.metadata`, with the rendered user data under `user-data`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *cloudInitConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state cloudInitConfigModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, parts, diags := expandCloudInitConfig(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudConfig, warnings, err := cfg.render()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid cloud-init config", err.Error())
+		return
+	}
+	for _, w := range warnings {
+		resp.Diagnostics.AddWarning("Unknown cloud-config key", w)
+	}
+
+	userData, err := renderUserData(cloudConfig, parts)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid cloud-init config", err.Error())
+		return
+	}
+
+	rendered, err := encodeUserData(userData, state.Gzip.ValueBool(), state.Base64Encode.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid cloud-init config", err.Error())
+		return
+	}
+
+	metadata := map[string]attr.Value{
+		userDataMetadataKey: types.StringValue(rendered),
+	}
+	if state.EnableOsLogin.ValueBool() {
+		metadata[enableOsLoginMetadataKey] = types.StringValue("true")
+	}
+
+	sum := sha256.Sum256([]byte(rendered))
+	state.ID = types.StringValue(hex.EncodeToString(sum[:]))
+	state.Rendered = types.StringValue(rendered)
+	state.Metadata = types.MapValueMust(types.StringType, metadata)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func expandCloudInitConfig(ctx context.Context, state *cloudInitConfigModel) (*cloudConfig, []mimePart, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	cfg := &cloudConfig{
+		PackageUpdate:  state.PackageUpdate.ValueBool(),
+		PackageUpgrade: state.PackageUpgrade.ValueBool(),
+		Extra:          state.CloudConfig.ValueString(),
+	}
+
+	diags.Append(state.SSHAuthorizedKeys.ElementsAs(ctx, &cfg.SSHAuthorizedKeys, false)...)
+	diags.Append(state.Packages.ElementsAs(ctx, &cfg.Packages, false)...)
+	diags.Append(state.Runcmd.ElementsAs(ctx, &cfg.Runcmd, false)...)
+
+	var users []userModel
+	diags.Append(state.Users.ElementsAs(ctx, &users, false)...)
+	for _, u := range users {
+		user := cloudConfigUser{
+			Name:  u.Name.ValueString(),
+			Gecos: u.Gecos.ValueString(),
+			Sudo:  u.Sudo.ValueString(),
+			Shell: u.Shell.ValueString(),
+		}
+		if !u.LockPasswd.IsNull() {
+			user.LockPasswd = u.LockPasswd.ValueBoolPointer()
+		}
+		diags.Append(u.Groups.ElementsAs(ctx, &user.Groups, false)...)
+		diags.Append(u.SSHAuthorizedKeys.ElementsAs(ctx, &user.SSHAuthorizedKeys, false)...)
+		cfg.Users = append(cfg.Users, user)
+	}
+
+	var files []writeFileModel
+	diags.Append(state.WriteFiles.ElementsAs(ctx, &files, false)...)
+	for _, f := range files {
+		cfg.WriteFiles = append(cfg.WriteFiles, cloudConfigFile{
+			Path:        f.Path.ValueString(),
+			Content:     f.Content.ValueString(),
+			Permissions: f.Permissions.ValueString(),
+			Owner:       f.Owner.ValueString(),
+			Append:      f.Append.ValueBool(),
+		})
+	}
+
+	var partModels []partModel
+	diags.Append(state.Parts.ElementsAs(ctx, &partModels, false)...)
+	parts := make([]mimePart, 0, len(partModels))
+	for _, p := range partModels {
+		parts = append(parts, mimePart{
+			ContentType: p.ContentType.ValueString(),
+			Content:     p.Content.ValueString(),
+			Filename:    p.Filename.ValueString(),
+			MergeType:   p.MergeType.ValueString(),
+		})
+	}
+
+	return cfg, parts, diags
+}
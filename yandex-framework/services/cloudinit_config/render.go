@@ -0,0 +1,298 @@
+package cloudinit_config
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	cloudConfigHeader = "#cloud-config\n"
+	mimeBoundary      = "MIMEBOUNDARY"
+)
+
+var (
+	userNameRegexp    = regexp.MustCompile(`^[a-z_][a-z0-9_-]*\$?$`)
+	permissionsRegexp = regexp.MustCompile(`^0?[0-7]{3,4}$`)
+
+	partContentTypes = []string{
+		"text/cloud-boothook",
+		"text/cloud-config",
+		"text/cloud-config-archive",
+		"text/jinja2",
+		"text/part-handler",
+		"text/upstart-job",
+		"text/x-include-once-url",
+		"text/x-include-url",
+		"text/x-shellscript",
+		"text/x-shellscript-per-boot",
+		"text/x-shellscript-per-instance",
+		"text/x-shellscript-per-once",
+	}
+
+	// cloudConfigKeys are the top-level keys of the cloud-config schema, used to catch typos in cloud_config.
+	cloudConfigKeys = map[string]bool{
+		"allow_public_ssh_keys": true, "ansible": true, "apk_repos": true, "apt": true, "apt_pipelining": true,
+		"authkey_hash": true, "autoinstall": true, "bootcmd": true, "byobu_by_default": true, "ca_certs": true,
+		"chef": true, "chpasswd": true, "cloud_config_modules": true, "cloud_final_modules": true,
+		"cloud_init_modules": true, "create_hostname_file": true, "device_aliases": true, "disable_ec2_metadata": true,
+		"disable_root": true, "disable_root_opts": true, "disk_setup": true, "drivers": true, "fan": true,
+		"final_message": true, "fqdn": true, "fs_setup": true, "groups": true, "growpart": true, "hostname": true,
+		"keyboard": true, "landscape": true, "locale": true, "locale_configfile": true, "lxd": true,
+		"manage_etc_hosts": true, "manage_resolv_conf": true, "mcollective": true, "merge_how": true,
+		"merge_type": true, "mount_default_fields": true, "mounts": true, "no_ssh_fingerprints": true, "ntp": true,
+		"output": true, "package_reboot_if_required": true, "package_update": true, "package_upgrade": true,
+		"packages": true, "password": true, "phone_home": true, "power_state": true, "prefer_fqdn_over_hostname": true,
+		"preserve_hostname": true, "puppet": true, "random_seed": true, "reporting": true, "resize_rootfs": true,
+		"resolv_conf": true, "rh_subscription": true, "rsyslog": true, "runcmd": true, "salt_minion": true,
+		"snap": true, "spacewalk": true, "ssh": true, "ssh_authorized_keys": true, "ssh_deletekeys": true,
+		"ssh_fp_console_blacklist": true, "ssh_genkeytypes": true, "ssh_key_console_blacklist": true,
+		"ssh_keys": true, "ssh_publish_hostkeys": true, "ssh_pwauth": true, "ssh_quiet_keygen": true,
+		"swap": true, "system_info": true, "timezone": true, "ubuntu_advantage": true, "ubuntu_pro": true,
+		"updates": true, "user": true, "users": true, "vendor_data": true, "wireguard": true, "write_files": true,
+		"yum_repo_dir": true, "yum_repos": true, "zypper": true,
+	}
+)
+
+type cloudConfig struct {
+	Users             []cloudConfigUser
+	SSHAuthorizedKeys []string
+	PackageUpdate     bool
+	PackageUpgrade    bool
+	Packages          []string
+	WriteFiles        []cloudConfigFile
+	Runcmd            []string
+	// Extra is a raw cloud-config document merged into the generated one.
+	Extra string
+}
+
+type cloudConfigUser struct {
+	Name              string   `yaml:"name"`
+	Gecos             string   `yaml:"gecos,omitempty"`
+	Groups            []string `yaml:"groups,omitempty"`
+	Sudo              string   `yaml:"sudo,omitempty"`
+	Shell             string   `yaml:"shell,omitempty"`
+	LockPasswd        *bool    `yaml:"lock_passwd,omitempty"`
+	SSHAuthorizedKeys []string `yaml:"ssh_authorized_keys,omitempty"`
+}
+
+type cloudConfigFile struct {
+	Path        string `yaml:"path"`
+	Content     string `yaml:"content"`
+	Permissions string `yaml:"permissions,omitempty"`
+	Owner       string `yaml:"owner,omitempty"`
+	Append      bool   `yaml:"append,omitempty"`
+}
+
+type mimePart struct {
+	ContentType string
+	Content     string
+	Filename    string
+	MergeType   string
+}
+
+// render validates the config and returns the `#cloud-config` document, or an empty string if nothing is set.
+// Unknown keys in Extra are returned as warnings, since cloud-init ignores them.
+func (c *cloudConfig) render() (string, []string, error) {
+	doc := map[string]interface{}{}
+	var warnings []string
+
+	if c.Extra != "" {
+		if err := yaml.Unmarshal([]byte(c.Extra), &doc); err != nil {
+			return "", nil, fmt.Errorf("cloud_config must be a YAML object: %w", err)
+		}
+		if doc == nil {
+			doc = map[string]interface{}{}
+		}
+
+		keys := make([]string, 0, len(doc))
+		for k := range doc {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if !cloudConfigKeys[k] {
+				warnings = append(warnings, fmt.Sprintf("cloud_config key %q is not a known cloud-config key and will be ignored by cloud-init", k))
+			}
+		}
+	}
+
+	set := func(key string, value interface{}) error {
+		if _, ok := doc[key]; ok {
+			return fmt.Errorf("%q is set both in cloud_config and as an attribute", key)
+		}
+		doc[key] = value
+		return nil
+	}
+
+	if len(c.Users) > 0 {
+		for i, u := range c.Users {
+			if !userNameRegexp.MatchString(u.Name) {
+				return "", nil, fmt.Errorf("users[%d]: invalid user name %q", i, u.Name)
+			}
+			if err := validateSSHKeys(fmt.Sprintf("users[%d].ssh_authorized_keys", i), u.SSHAuthorizedKeys); err != nil {
+				return "", nil, err
+			}
+		}
+		if err := set("users", c.Users); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if len(c.SSHAuthorizedKeys) > 0 {
+		if err := validateSSHKeys("ssh_authorized_keys", c.SSHAuthorizedKeys); err != nil {
+			return "", nil, err
+		}
+		if err := set("ssh_authorized_keys", c.SSHAuthorizedKeys); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if c.PackageUpdate {
+		if err := set("package_update", true); err != nil {
+			return "", nil, err
+		}
+	}
+	if c.PackageUpgrade {
+		if err := set("package_upgrade", true); err != nil {
+			return "", nil, err
+		}
+	}
+	if len(c.Packages) > 0 {
+		if err := set("packages", c.Packages); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if len(c.WriteFiles) > 0 {
+		for i, f := range c.WriteFiles {
+			if !path.IsAbs(f.Path) {
+				return "", nil, fmt.Errorf("write_files[%d]: path %q must be absolute", i, f.Path)
+			}
+			if f.Permissions != "" && !permissionsRegexp.MatchString(f.Permissions) {
+				return "", nil, fmt.Errorf("write_files[%d]: permissions %q must be an octal mode, e.g. \"0644\"", i, f.Permissions)
+			}
+		}
+		if err := set("write_files", c.WriteFiles); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if len(c.Runcmd) > 0 {
+		if err := set("runcmd", c.Runcmd); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if len(doc) == 0 {
+		return "", warnings, nil
+	}
+
+	out, err := yaml.Marshal(doc)
+	if err != nil {
+		return "", nil, err
+	}
+	return cloudConfigHeader + string(out), warnings, nil
+}
+
+func validateSSHKeys(attr string, keys []string) error {
+	for i, key := range keys {
+		if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key)); err != nil {
+			return fmt.Errorf("%s[%d]: invalid SSH public key: %w", attr, i, err)
+		}
+	}
+	return nil
+}
+
+// renderUserData returns the cloud-config document as is if there are no parts,
+// otherwise a multi-part MIME message with the cloud-config document as the first part.
+func renderUserData(cloudConfig string, parts []mimePart) (string, error) {
+	if len(parts) == 0 {
+		return cloudConfig, nil
+	}
+
+	if cloudConfig != "" {
+		parts = append([]mimePart{{ContentType: "text/cloud-config", Content: cloudConfig}}, parts...)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("Content-Type: multipart/mixed; boundary=\"" + mimeBoundary + "\"\r\n")
+	buf.WriteString("MIME-Version: 1.0\r\n\r\n")
+
+	w := multipart.NewWriter(&buf)
+	if err := w.SetBoundary(mimeBoundary); err != nil {
+		return "", err
+	}
+
+	for i, p := range parts {
+		if !isValidPartContentType(p.ContentType) {
+			return "", fmt.Errorf("parts[%d]: unsupported content_type %q, must be one of %s", i, p.ContentType, strings.Join(partContentTypes, ", "))
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", p.ContentType)
+		header.Set("Content-Transfer-Encoding", "7bit")
+		header.Set("Mime-Version", "1.0")
+		if p.Filename != "" {
+			header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", p.Filename))
+		}
+		if p.MergeType != "" {
+			header.Set("X-Merge-Type", p.MergeType)
+		}
+
+		pw, err := w.CreatePart(header)
+		if err != nil {
+			return "", err
+		}
+		if _, err := pw.Write([]byte(p.Content)); err != nil {
+			return "", err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func isValidPartContentType(contentType string) bool {
+	for _, t := range partContentTypes {
+		if t == contentType {
+			return true
+		}
+	}
+	return false
+}
+
+func encodeUserData(userData string, gzipped, base64Encoded bool) (string, error) {
+	if !gzipped {
+		if base64Encoded {
+			return base64.StdEncoding.EncodeToString([]byte(userData)), nil
+		}
+		return userData, nil
+	}
+
+	if !base64Encoded {
+		return "", fmt.Errorf("base64_encode must be enabled when gzip is enabled")
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write([]byte(userData)); err != nil {
+		return "", err
+	}
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
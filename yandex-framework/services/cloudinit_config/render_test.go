@@ -0,0 +1,151 @@
+package cloudinit_config
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSSHKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIO2SCzLsfMjJGCGyfWnGA6SXQzz9IqlWeefiOri/q6AE test"
+
+func TestCloudConfigRender(t *testing.T) {
+	lock := true
+	cfg := &cloudConfig{
+		Users: []cloudConfigUser{{
+			Name:              "ubuntu",
+			Groups:            []string{"sudo"},
+			Sudo:              "ALL=(ALL) NOPASSWD:ALL",
+			LockPasswd:        &lock,
+			SSHAuthorizedKeys: []string{testSSHKey},
+		}},
+		PackageUpdate: true,
+		Packages:      []string{"nginx"},
+		WriteFiles:    []cloudConfigFile{{Path: "/etc/motd", Content: "hello\n", Permissions: "0644"}},
+		Runcmd:        []string{"systemctl restart nginx"},
+		Extra:         "timezone: Europe/Moscow\n",
+	}
+
+	doc, warnings, err := cfg.render()
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+	assert.Equal(t, `#cloud-config
+package_update: true
+packages:
+    - nginx
+runcmd:
+    - systemctl restart nginx
+timezone: Europe/Moscow
+users:
+    - name: ubuntu
+      groups:
+        - sudo
+      sudo: ALL=(ALL) NOPASSWD:ALL
+      lock_passwd: true
+      ssh_authorized_keys:
+        - `+testSSHKey+`
+write_files:
+    - path: /etc/motd
+      content: |
+        hello
+      permissions: "0644"
+`, doc)
+}
+
+func TestCloudConfigRenderEmpty(t *testing.T) {
+	doc, warnings, err := (&cloudConfig{}).render()
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+	assert.Empty(t, doc)
+}
+
+func TestCloudConfigRenderValidation(t *testing.T) {
+	tests := map[string]struct {
+		cfg *cloudConfig
+		err string
+	}{
+		"user name": {
+			cfg: &cloudConfig{Users: []cloudConfigUser{{Name: "Ubuntu User"}}},
+			err: "invalid user name",
+		},
+		"ssh key": {
+			cfg: &cloudConfig{SSHAuthorizedKeys: []string{"ssh-rsa not-a-key"}},
+			err: "invalid SSH public key",
+		},
+		"relative path": {
+			cfg: &cloudConfig{WriteFiles: []cloudConfigFile{{Path: "etc/motd"}}},
+			err: "must be absolute",
+		},
+		"permissions": {
+			cfg: &cloudConfig{WriteFiles: []cloudConfigFile{{Path: "/etc/motd", Permissions: "rw-r--r--"}}},
+			err: "must be an octal mode",
+		},
+		"conflict": {
+			cfg: &cloudConfig{Packages: []string{"nginx"}, Extra: "packages: [curl]"},
+			err: `"packages" is set both`,
+		},
+		"not an object": {
+			cfg: &cloudConfig{Extra: "- a"},
+			err: "must be a YAML object",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := tc.cfg.render()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func TestCloudConfigRenderUnknownKey(t *testing.T) {
+	_, warnings, err := (&cloudConfig{Extra: "runcmnd: [ls]\n"}).render()
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], `"runcmnd"`)
+}
+
+func TestRenderUserDataMultipart(t *testing.T) {
+	userData, err := renderUserData("#cloud-config\npackages: [nginx]\n", []mimePart{
+		{ContentType: "text/x-shellscript", Content: "#!/bin/sh\necho hi\n", Filename: "hi.sh"},
+	})
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(userData, "Content-Type: multipart/mixed; boundary=\"MIMEBOUNDARY\"\r\nMIME-Version: 1.0\r\n\r\n"))
+	assert.Equal(t, 2, strings.Count(userData, "--MIMEBOUNDARY\r\n"))
+	assert.Contains(t, userData, "Content-Type: text/cloud-config\r\n")
+	assert.Contains(t, userData, "Content-Disposition: attachment; filename=\"hi.sh\"\r\n")
+	assert.True(t, strings.HasSuffix(userData, "--MIMEBOUNDARY--\r\n"))
+
+	_, err = renderUserData("", []mimePart{{ContentType: "text/plain", Content: "x"}})
+	assert.Error(t, err)
+
+	userData, err = renderUserData("#cloud-config\n", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "#cloud-config\n", userData)
+}
+
+func TestEncodeUserData(t *testing.T) {
+	encoded, err := encodeUserData("#cloud-config\n", false, true)
+	require.NoError(t, err)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("#cloud-config\n")), encoded)
+
+	encoded, err = encodeUserData("#cloud-config\n", true, true)
+	require.NoError(t, err)
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	require.NoError(t, err)
+	zr, err := gzip.NewReader(bytes.NewReader(raw))
+	require.NoError(t, err)
+	decoded, err := io.ReadAll(zr)
+	require.NoError(t, err)
+	assert.Equal(t, "#cloud-config\n", string(decoded))
+
+	_, err = encodeUserData("#cloud-config\n", true, false)
+	assert.Error(t, err)
+}
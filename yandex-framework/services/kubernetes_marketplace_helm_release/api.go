@@ -85,6 +85,41 @@ func updateHelmRelease(ctx context.Context, sdk *ycsdk.SDK, req *marketplace.Upd
 	})
 }
 
+// waitHelmReleaseDeployed polls the release until it leaves the pending states.
+func waitHelmReleaseDeployed(ctx context.Context, sdk *ycsdk.SDK, id string) diag.Diagnostic {
+	for {
+		hr, d := getHelmRelease(ctx, sdk, id)
+		if d != nil {
+			return d
+		}
+		if hr == nil {
+			return diag.NewErrorDiagnostic(
+				"Helm Release not found",
+				fmt.Sprintf("Helm Release with id %s not found", id),
+			)
+		}
+
+		switch hr.GetStatus() {
+		case marketplace.HelmRelease_DEPLOYED:
+			return nil
+		case marketplace.HelmRelease_FAILED, marketplace.HelmRelease_UNINSTALLED:
+			return diag.NewErrorDiagnostic(
+				"Failed to update Helm release",
+				fmt.Sprintf("Helm Release %s is in status %s", id, hr.GetStatus()),
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return diag.NewErrorDiagnostic(
+				"Failed to update Helm release",
+				fmt.Sprintf("Timed out waiting for Helm Release %s to be deployed, last status %s", id, hr.GetStatus()),
+			)
+		case <-time.After(statusPollInterval):
+		}
+	}
+}
+
 func uninstallHelmRelease(ctx context.Context, sdk *ycsdk.SDK, req *marketplace.UninstallHelmReleaseRequest) diag.Diagnostic {
 	if req == nil {
 		return nil
@@ -24,6 +24,7 @@ type helmReleaseResourceModel struct {
 	Status           types.String   `tfsdk:"status"`
 	CreatedAt        types.String   `tfsdk:"created_at"`
 	UserValues       types.Map      `tfsdk:"user_values"`
+	Values           ValuesValue    `tfsdk:"values"`
 	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
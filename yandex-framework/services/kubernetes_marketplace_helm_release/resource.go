@@ -26,6 +26,7 @@ const (
 	yandexMarketplaceHelmReleaseTimeout = 10 * time.Minute
 
 	installationErrorWaitTime = 1 * time.Minute // when install fails, HelmRelease may still be created, but not appear immediately
+	statusPollInterval        = 5 * time.Second
 
 	nameValue      = "applicationName"
 	namespaceValue = "namespace"
@@ -58,7 +59,7 @@ func (r *helmReleaseResource) Schema(ctx context.Context, _ resource.SchemaReque
 		MarkdownDescription: "Allows management of Kubernetes product installed from Yandex Cloud Marketplace.\nFor more information, see [official documentation](https://yandex.cloud/marketplace?type=K8S).",
 		Attributes: map[string]schema.Attribute{
 			"product_version": schema.StringAttribute{
-				MarkdownDescription: "The ID of the product version to be installed.",
+				MarkdownDescription: "The ID of the product version to be installed. Changing it upgrades the release in place and waits until the new version is deployed.",
 				Required:            true,
 			},
 			"cluster_id": schema.StringAttribute{
@@ -95,6 +96,16 @@ func (r *helmReleaseResource) Schema(ctx context.Context, _ resource.SchemaReque
 					mapplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"values": schema.StringAttribute{
+				MarkdownDescription: "Values to be passed for the installation of the product, as a YAML or JSON document. " +
+					"Unlike `user_values`, nested objects, lists, booleans and numbers can be expressed. " +
+					"Nested keys are passed to the product as dot-separated paths, and list items as indexed paths (e.g. `controller.args[0]`). Dots and brackets inside keys are escaped with a backslash (e.g. `nodeSelector.kubernetes\\.io/os`), and empty objects and lists are passed as `{}` and `[]`. " +
+					"Changes in formatting or key order do not produce a diff.\n" +
+					"~> Keys specified in `user_values` take precedence over the same keys in this document.\n",
+				CustomType: ValuesType{},
+				Optional:   true,
+				Sensitive:  true,
+			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: common.ResourceDescriptions["id"],
 				Computed:            true,
@@ -300,6 +311,13 @@ func (r *helmReleaseResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	// The operation completes once the upgrade is scheduled, wait for the release to settle
+	d = waitHelmReleaseDeployed(ctx, r.providerConfig.SDK, state.ID.ValueString())
+	resp.Diagnostics.Append(d)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	diags = updateState(ctx, r.providerConfig.SDK, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -373,19 +391,30 @@ func helmReleaseIDLogField(id string) map[string]interface{} {
 }
 
 func userValuesFromPlan(ctx context.Context, plan helmReleaseResourceModel) ([]*marketplace.ValueWithKey, diag.Diagnostics) {
-	var planValues map[string]string
+	planValues := make(map[string]string)
 
 	var diags diag.Diagnostics
 
-	if !plan.UserValues.IsNull() {
-		diags = plan.UserValues.ElementsAs(ctx, &planValues, false)
-		if diags.HasError() {
+	if !plan.Values.IsNull() {
+		docValues, err := flattenValues(plan.Values.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("values"), "Invalid values", err.Error())
 			return nil, diags
 		}
+		for k, v := range docValues {
+			planValues[k] = v
+		}
 	}
 
-	if planValues == nil {
-		planValues = make(map[string]string)
+	if !plan.UserValues.IsNull() {
+		var userValues map[string]string
+		diags = plan.UserValues.ElementsAs(ctx, &userValues, false)
+		if diags.HasError() {
+			return nil, diags
+		}
+		for k, v := range userValues {
+			planValues[k] = v
+		}
 	}
 
 	if _, ok := planValues[nameValue]; !ok {
@@ -2,6 +2,7 @@ package kubernetes_marketplace_helm_release
 
 import (
 	"context"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -130,3 +131,113 @@ loop:
 	}
 	return true
 }
+
+func TestUserValuesFromPlanWithValues(t *testing.T) {
+	plan := helmReleaseResourceModel{
+		Name:      types.StringValue("app-a"),
+		Namespace: types.StringValue("ns-a"),
+		Values: ValuesValue(types.StringValue(`
+controller:
+  replicas: 2
+  enabled: true
+  args: ["--a", "--b"]
+namespace: ns-doc
+`)),
+		UserValues: types.MapValueMust(
+			types.StringType,
+			map[string]attr.Value{
+				"controller.replicas": types.StringValue("3"),
+			},
+		),
+	}
+
+	expected := map[string]string{
+		"controller.replicas": "3",
+		"controller.enabled":  "true",
+		"controller.args[0]":  "--a",
+		"controller.args[1]":  "--b",
+		nameValue:             "app-a",
+		namespaceValue:        "ns-doc",
+	}
+
+	values, diags := userValuesFromPlan(context.Background(), plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(values) != len(expected) {
+		t.Fatalf("expected %d values, got %d: %v", len(expected), len(values), values)
+	}
+	for _, v := range values {
+		if expected[v.Key] != v.Value.GetTypedValue() {
+			t.Errorf("value %q: expected %q, got %q", v.Key, expected[v.Key], v.Value.GetTypedValue())
+		}
+	}
+}
+
+func TestValuesSemanticEquals(t *testing.T) {
+	tests := map[string]struct {
+		old, new string
+		equal    bool
+	}{
+		"reordered": {
+			old:   "a: 1\nb:\n  c: true\n",
+			new:   "b: {c: true}\na: 1\n",
+			equal: true,
+		},
+		"json": {
+			old:   "a: [1, 2]\n",
+			new:   `{"a": [1, 2]}`,
+			equal: true,
+		},
+		"changed": {
+			old:   "a: 1\n",
+			new:   "a: 2\n",
+			equal: false,
+		},
+	}
+
+	ctx := context.Background()
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			oldValue := ValuesValue(types.StringValue(tc.old))
+			equal, _ := oldValue.StringSemanticEquals(ctx, ValuesValue(types.StringValue(tc.new)))
+			if equal != tc.equal {
+				t.Errorf("expected %v, got %v", tc.equal, equal)
+			}
+		})
+	}
+}
+
+func TestFlattenValuesRejectsNonObject(t *testing.T) {
+	if _, err := flattenValues("- a\n- b\n"); err == nil {
+		t.Error("expected an error for a list document")
+	}
+}
+
+func TestFlattenValues(t *testing.T) {
+	values, err := flattenValues(`
+nodeSelector:
+  kubernetes.io/os: linux
+  "a[0]": x
+extraArgs: {}
+tolerations: []
+controller:
+  args: ["--a"]
+`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		`nodeSelector.kubernetes\.io/os`: "linux",
+		`nodeSelector.a\[0\]`:            "x",
+		"extraArgs":                      "{}",
+		"tolerations":                    "[]",
+		"controller.args[0]":             "--a",
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected %v, got %v", expected, values)
+	}
+}
@@ -0,0 +1,224 @@
+package kubernetes_marketplace_helm_release
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	_ = xattr.TypeWithValidate(ValuesType{})
+	_ = basetypes.StringValuable(&ValuesValue{})
+	_ = basetypes.StringValuableWithSemanticEquals(&ValuesValue{})
+	_ = fmt.Stringer(&ValuesValue{})
+)
+
+// ValuesType is a custom type for a YAML (or JSON) document with chart values.
+// Documents that decode to the same data are considered equal, so formatting,
+// key order and comments do not produce diffs.
+type ValuesType struct {
+	basetypes.StringType
+}
+
+type ValuesValue basetypes.StringValue
+
+func (t ValuesType) Equal(o attr.Type) bool {
+	_, ok := o.(ValuesType)
+
+	return ok
+}
+
+func (t ValuesType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	v, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	str, ok := v.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type %T", v)
+	}
+
+	return ValuesValue(str), nil
+}
+
+func (t ValuesType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ValuesValue(in), nil
+}
+
+func (t ValuesType) ValueType(ctx context.Context) attr.Value {
+	return ValuesValue{}
+}
+
+func (t ValuesType) Validate(ctx context.Context, in tftypes.Value, p path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in.IsNull() || !in.IsKnown() {
+		return diags
+	}
+
+	var doc string
+	if err := in.As(&doc); err != nil {
+		diags.AddAttributeError(p, "Invalid values", err.Error())
+		return diags
+	}
+
+	if _, err := flattenValues(doc); err != nil {
+		diags.AddAttributeError(p, "Invalid values", err.Error())
+	}
+	return diags
+}
+
+func (v ValuesValue) Type(ctx context.Context) attr.Type {
+	return ValuesType{}
+}
+
+func (v ValuesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	return basetypes.StringValue(v).ToTerraformValue(ctx)
+}
+
+func (v ValuesValue) Equal(o attr.Value) bool {
+	other, ok := o.(ValuesValue)
+	if !ok {
+		return false
+	}
+	return basetypes.StringValue(v).Equal(basetypes.StringValue(other))
+}
+
+func (v ValuesValue) IsNull() bool {
+	return basetypes.StringValue(v).IsNull()
+}
+
+func (v ValuesValue) IsUnknown() bool {
+	return basetypes.StringValue(v).IsUnknown()
+}
+
+func (v ValuesValue) String() string {
+	return basetypes.StringValue(v).String()
+}
+
+func (v ValuesValue) ValueString() string {
+	return basetypes.StringValue(v).ValueString()
+}
+
+func (v ValuesValue) ToStringValue(ctx context.Context) (basetypes.StringValue, diag.Diagnostics) {
+	return basetypes.StringValue(v), nil
+}
+
+func (v ValuesValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	newVal, ok := newValuable.(ValuesValue)
+	if !ok {
+		return false, nil
+	}
+
+	if newVal.IsNull() || newVal.IsUnknown() || v.IsNull() || v.IsUnknown() {
+		return newVal.Equal(v), nil
+	}
+
+	var oldDoc, newDoc interface{}
+	if err := yaml.Unmarshal([]byte(v.ValueString()), &oldDoc); err != nil {
+		return false, nil
+	}
+	if err := yaml.Unmarshal([]byte(newVal.ValueString()), &newDoc); err != nil {
+		return false, nil
+	}
+
+	return reflect.DeepEqual(oldDoc, newDoc), nil
+}
+
+// flattenValues converts a YAML document into the flat key/value form accepted
+// by the Marketplace API: nested keys are joined with dots and list items are
+// addressed by index, e.g. `controller.args[0]`. Dots, brackets and backslashes
+// inside keys are escaped with a backslash as in `helm --set`, so such keys are
+// not confused with nesting. Empty objects and lists are kept as `{}` and `[]`.
+func flattenValues(doc string) (map[string]string, error) {
+	var data interface{}
+	if err := yaml.Unmarshal([]byte(doc), &data); err != nil {
+		return nil, fmt.Errorf("values must be a valid YAML or JSON document: %w", err)
+	}
+
+	result := make(map[string]string)
+	if data == nil {
+		return result, nil
+	}
+
+	root, ok := data.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("values must be a YAML or JSON object, got %T", data)
+	}
+
+	if err := flattenValue("", root, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func flattenValue(prefix string, value interface{}, result map[string]string) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			result[prefix] = "{}"
+			return nil
+		}
+
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			key := escapeValuesKey(k)
+			if prefix != "" {
+				key = prefix + "." + key
+			}
+			if err := flattenValue(key, v[k], result); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			result[prefix] = "[]"
+			return nil
+		}
+		for i, item := range v {
+			if err := flattenValue(fmt.Sprintf("%s[%d]", prefix, i), item, result); err != nil {
+				return err
+			}
+		}
+	case nil:
+		result[prefix] = ""
+	case string:
+		result[prefix] = v
+	case bool:
+		result[prefix] = strconv.FormatBool(v)
+	case int:
+		result[prefix] = strconv.Itoa(v)
+	case int64:
+		result[prefix] = strconv.FormatInt(v, 10)
+	case uint64:
+		result[prefix] = strconv.FormatUint(v, 10)
+	case float64:
+		result[prefix] = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Errorf("unsupported value type %T for key %q", v, prefix)
+	}
+	return nil
+}
+
+var valuesKeyReplacer = strings.NewReplacer(`\`, `\\`, `.`, `\.`, `[`, `\[`, `]`, `\]`)
+
+// escapeValuesKey escapes the characters that have a special meaning in flat value keys.
+func escapeValuesKey(key string) string {
+	return valuesKeyReplacer.Replace(key)
+}
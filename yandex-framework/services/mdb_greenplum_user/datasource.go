@@ -76,11 +76,12 @@ func (d *bindingDataSource) Schema(ctx context.Context, _ datasource.SchemaReque
 }
 
 func (d *bindingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var state User
-	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	var config DatasourceUser
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state := config.toUser()
 
 	cid := state.ClusterID.ValueString()
 	userName := state.Name.ValueString()
@@ -90,7 +91,7 @@ func (d *bindingDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 	state.Id = types.StringValue(resourceid.Construct(cid, userName))
 
-	userToState(user, &state)
+	userToState(user, state)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newDatasourceUser(state))...)
 }
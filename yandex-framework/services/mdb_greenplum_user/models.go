@@ -15,6 +15,42 @@ type User struct {
 	Password      *string        `tfsdk:"password"`
 	ResourceGroup types.String   `tfsdk:"resource_group"`
 	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+
+	GeneratePassword         types.Bool   `tfsdk:"generate_password"`
+	OutputToLockbox          types.Object `tfsdk:"output_to_lockbox"`
+	OutputToLockboxVersionID types.String `tfsdk:"output_to_lockbox_version_id"`
+}
+
+// DatasourceUser is the data source model. Unlike User, it has no output_to_lockbox attributes.
+type DatasourceUser struct {
+	Id            types.String   `tfsdk:"id"`
+	ClusterID     types.String   `tfsdk:"cluster_id"`
+	Name          types.String   `tfsdk:"name"`
+	Password      *string        `tfsdk:"password"`
+	ResourceGroup types.String   `tfsdk:"resource_group"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (u *DatasourceUser) toUser() *User {
+	return &User{
+		Id:            u.Id,
+		ClusterID:     u.ClusterID,
+		Name:          u.Name,
+		Password:      u.Password,
+		ResourceGroup: u.ResourceGroup,
+		Timeouts:      u.Timeouts,
+	}
+}
+
+func newDatasourceUser(u *User) *DatasourceUser {
+	return &DatasourceUser{
+		Id:            u.Id,
+		ClusterID:     u.ClusterID,
+		Name:          u.Name,
+		Password:      u.Password,
+		ResourceGroup: u.ResourceGroup,
+		Timeouts:      u.Timeouts,
+	}
 }
 
 func userToState(user *greenplum.User, state *User) {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -14,6 +15,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/lockboxoutput"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 )
@@ -23,6 +26,9 @@ const (
 	yandexMDBGreenplumUserUpdateTimeout  = 120 * time.Minute
 )
 
+// userSensitiveAttrs are stored into Lockbox when output_to_lockbox is supplied.
+var userSensitiveAttrs = []string{"password"}
+
 type bindingResource struct {
 	providerConfig *provider_config.Config
 }
@@ -56,7 +62,7 @@ func (r *bindingResource) Configure(_ context.Context,
 func getSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "Manages a Greenplum user within the Yandex Cloud.",
-		Attributes: map[string]schema.Attribute{
+		Attributes: lockboxoutput.ExtendWithOutputToLockbox(map[string]schema.Attribute{
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true,
 				Update: true,
@@ -84,15 +90,16 @@ func getSchema(ctx context.Context) schema.Schema {
 				},
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "The password of the user.",
+				MarkdownDescription: "The password of the user.\n\n~> **Must specify either password or generate_password**.\n",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"generate_password": mdbcommon.GeneratePasswordAttribute("password"),
 			"resource_group": schema.StringAttribute{
 				MarkdownDescription: "The resource group of the user.",
 				Optional:            true,
 			},
-		},
+		}, userSensitiveAttrs, "generate_password"),
 	}
 }
 
@@ -139,6 +146,13 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 
 	cid := plan.ClusterID.ValueString()
 	userPlan := userFromState(ctx, &plan)
+	generatedPassword := mdbcommon.GeneratedPassword(types.BoolNull(), plan.GeneratePassword, lockboxoutput.NullValue(userSensitiveAttrs), plan.OutputToLockbox, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if generatedPassword != "" {
+		userPlan.Password = generatedPassword
+	}
 
 	createUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, userPlan)
 	if resp.Diagnostics.HasError() {
@@ -146,6 +160,7 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	plan.Id = types.StringValue(resourceid.Construct(cid, userPlan.Name))
+	r.manageOutputToLockbox(ctx, nil, &plan, generatedPassword, &resp.Diagnostics)
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
@@ -181,6 +196,13 @@ func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest
 	cid := plan.ClusterID.ValueString()
 	userState := userFromState(ctx, &state)
 	userPlan := userFromState(ctx, &plan)
+	generatedPassword := mdbcommon.GeneratedPassword(state.GeneratePassword, plan.GeneratePassword, state.OutputToLockbox, plan.OutputToLockbox, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if generatedPassword != "" {
+		userPlan.Password = generatedPassword
+	}
 	updatePaths := getUpdatePaths(userPlan, userState)
 
 	if len(updatePaths) > 0 {
@@ -191,6 +213,7 @@ func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	state.Id = types.StringValue(resourceid.Construct(cid, userPlan.Name))
+	r.manageOutputToLockbox(ctx, &state, &plan, generatedPassword, &resp.Diagnostics)
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
@@ -214,6 +237,11 @@ func (r *bindingResource) Delete(ctx context.Context, req resource.DeleteRequest
 	cid := state.ClusterID.ValueString()
 	dbName := state.Name.ValueString()
 	deleteUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, dbName)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(lockboxoutput.Destroy(ctx, r.providerConfig.SDK, state.OutputToLockbox, state.OutputToLockboxVersionID)...)
 }
 
 func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -233,6 +261,9 @@ func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportSt
 	userToState(user, &state)
 	state.Id = types.StringValue(req.ID)
 	state.ClusterID = types.StringValue(clusterId)
+	state.GeneratePassword = types.BoolValue(false)
+	state.OutputToLockbox = lockboxoutput.NullValue(userSensitiveAttrs)
+	state.OutputToLockboxVersionID = types.StringNull()
 
 	state.Timeouts = timeouts.Value{
 		Object: types.ObjectNull(map[string]attr.Type{
@@ -245,3 +276,40 @@ func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportSt
 	diags := resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
+
+// manageOutputToLockbox stores the user password into Lockbox when output_to_lockbox is supplied.
+// prior is nil when the user is being created; generatedPassword is stored instead of the configured one, if set.
+func (r *bindingResource) manageOutputToLockbox(ctx context.Context, prior, plan *User, generatedPassword string, respDiagnostics *diag.Diagnostics) {
+	priorOutput := lockboxoutput.NullValue(userSensitiveAttrs)
+	priorVersionID := types.StringNull()
+	var priorValues map[string]string
+	if prior != nil {
+		priorOutput = prior.OutputToLockbox
+		priorVersionID = prior.OutputToLockboxVersionID
+		priorValues = map[string]string{"password": stringOrEmpty(prior.Password)}
+	}
+
+	password := stringOrEmpty(plan.Password)
+	if generatedPassword != "" {
+		password = generatedPassword
+	}
+
+	versionID, diags := lockboxoutput.Manage(
+		ctx, r.providerConfig.SDK, userSensitiveAttrs,
+		priorOutput, plan.OutputToLockbox, priorVersionID,
+		priorValues, map[string]string{"password": password},
+	)
+	respDiagnostics.Append(diags...)
+	plan.OutputToLockboxVersionID = versionID
+	if diags.HasError() {
+		// keep the prior output_to_lockbox, so that it is not recorded as applied
+		plan.OutputToLockbox = priorOutput
+	}
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
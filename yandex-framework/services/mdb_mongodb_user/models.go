@@ -17,6 +17,42 @@ type User struct {
 	Password   types.String   `tfsdk:"password"`
 	Permission types.Set      `tfsdk:"permission"`
 	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+
+	GeneratePassword         types.Bool   `tfsdk:"generate_password"`
+	OutputToLockbox          types.Object `tfsdk:"output_to_lockbox"`
+	OutputToLockboxVersionID types.String `tfsdk:"output_to_lockbox_version_id"`
+}
+
+// DatasourceUser is the data source model. Unlike User, it has no output_to_lockbox attributes.
+type DatasourceUser struct {
+	Id         types.String   `tfsdk:"id"`
+	ClusterID  types.String   `tfsdk:"cluster_id"`
+	Name       types.String   `tfsdk:"name"`
+	Password   types.String   `tfsdk:"password"`
+	Permission types.Set      `tfsdk:"permission"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (u *DatasourceUser) toUser() *User {
+	return &User{
+		Id:         u.Id,
+		ClusterID:  u.ClusterID,
+		Name:       u.Name,
+		Password:   u.Password,
+		Permission: u.Permission,
+		Timeouts:   u.Timeouts,
+	}
+}
+
+func newDatasourceUser(u *User) *DatasourceUser {
+	return &DatasourceUser{
+		Id:         u.Id,
+		ClusterID:  u.ClusterID,
+		Name:       u.Name,
+		Password:   u.Password,
+		Permission: u.Permission,
+		Timeouts:   u.Timeouts,
+	}
 }
 
 type Permission struct {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -15,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/lockboxoutput"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
@@ -27,6 +30,9 @@ const (
 	yandexMDBMongoDBUserUpdateTimeout = 2 * time.Hour
 )
 
+// userSensitiveAttrs are stored into Lockbox when output_to_lockbox is supplied.
+var userSensitiveAttrs = []string{"password"}
+
 type bindingResource struct {
 	providerConfig *provider_config.Config
 }
@@ -60,7 +66,7 @@ func (r *bindingResource) Configure(_ context.Context,
 func (r *bindingResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a MongoDB user within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-mongodb/).",
-		Attributes: map[string]schema.Attribute{
+		Attributes: lockboxoutput.ExtendWithOutputToLockbox(map[string]schema.Attribute{
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true,
 				Update: true,
@@ -88,11 +94,12 @@ func (r *bindingResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "The password of the user.",
-				Required:            true,
+				MarkdownDescription: "The password of the user.\n\n~> **Must specify either password or generate_password**.\n",
+				Optional:            true,
 				Sensitive:           true,
 			},
-		},
+			"generate_password": mdbcommon.GeneratePasswordAttribute("password"),
+		}, userSensitiveAttrs, "generate_password"),
 		Blocks: map[string]schema.Block{
 			"permission": schema.SetNestedBlock{
 				MarkdownDescription: "Set of permissions granted to the user.",
@@ -170,9 +177,13 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 	cid := plan.ClusterID.ValueString()
 	userPlan, diags := userFromState(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	generatedPassword := mdbcommon.GeneratedPassword(types.BoolNull(), plan.GeneratePassword, lockboxoutput.NullValue(userSensitiveAttrs), plan.OutputToLockbox, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if generatedPassword != "" {
+		userPlan.Password = generatedPassword
+	}
 
 	createUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, userPlan)
 	if resp.Diagnostics.HasError() {
@@ -180,6 +191,7 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	plan.Id = types.StringValue(resourceid.Construct(cid, userPlan.Name))
+	r.manageOutputToLockbox(ctx, nil, &plan, generatedPassword, &resp.Diagnostics)
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
@@ -217,9 +229,13 @@ func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest
 	resp.Diagnostics.Append(diags...)
 	userPlan, diags := userFromState(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	generatedPassword := mdbcommon.GeneratedPassword(state.GeneratePassword, plan.GeneratePassword, state.OutputToLockbox, plan.OutputToLockbox, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if generatedPassword != "" {
+		userPlan.Password = generatedPassword
+	}
 	updatePaths := getUpdatePaths(userPlan, userState)
 
 	if len(updatePaths) > 0 {
@@ -230,6 +246,7 @@ func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	state.Id = types.StringValue(resourceid.Construct(cid, userPlan.Name))
+	r.manageOutputToLockbox(ctx, &state, &plan, generatedPassword, &resp.Diagnostics)
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
@@ -253,6 +270,11 @@ func (r *bindingResource) Delete(ctx context.Context, req resource.DeleteRequest
 	cid := state.ClusterID.ValueString()
 	dbName := state.Name.ValueString()
 	deleteUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, dbName)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(lockboxoutput.Destroy(ctx, r.providerConfig.SDK, state.OutputToLockbox, state.OutputToLockboxVersionID)...)
 }
 
 func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -270,6 +292,9 @@ func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportSt
 	}
 	var state User
 	resp.Diagnostics.Append(userToState(user, &state)...)
+	state.GeneratePassword = types.BoolValue(false)
+	state.OutputToLockbox = lockboxoutput.NullValue(userSensitiveAttrs)
+	state.OutputToLockboxVersionID = types.StringNull()
 
 	state.Timeouts = timeouts.Value{
 		Object: types.ObjectNull(map[string]attr.Type{
@@ -282,3 +307,33 @@ func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportSt
 	diags := resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
+
+// manageOutputToLockbox stores the user password into Lockbox when output_to_lockbox is supplied.
+// prior is nil when the user is being created; generatedPassword is stored instead of the configured one, if set.
+func (r *bindingResource) manageOutputToLockbox(ctx context.Context, prior, plan *User, generatedPassword string, respDiagnostics *diag.Diagnostics) {
+	priorOutput := lockboxoutput.NullValue(userSensitiveAttrs)
+	priorVersionID := types.StringNull()
+	var priorValues map[string]string
+	if prior != nil {
+		priorOutput = prior.OutputToLockbox
+		priorVersionID = prior.OutputToLockboxVersionID
+		priorValues = map[string]string{"password": prior.Password.ValueString()}
+	}
+
+	password := plan.Password.ValueString()
+	if generatedPassword != "" {
+		password = generatedPassword
+	}
+
+	versionID, diags := lockboxoutput.Manage(
+		ctx, r.providerConfig.SDK, userSensitiveAttrs,
+		priorOutput, plan.OutputToLockbox, priorVersionID,
+		priorValues, map[string]string{"password": password},
+	)
+	respDiagnostics.Append(diags...)
+	plan.OutputToLockboxVersionID = versionID
+	if diags.HasError() {
+		// keep the prior output_to_lockbox, so that it is not recorded as applied
+		plan.OutputToLockbox = priorOutput
+	}
+}
@@ -110,15 +110,16 @@ func (d *bindingDataSource) Schema(ctx context.Context, _ datasource.SchemaReque
 }
 
 func (d *bindingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var state User
-	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	var config DatasourceUser
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state := config.toUser()
 
 	cid := state.ClusterID.ValueString()
 	userName := state.Name.ValueString()
-	userRead(ctx, d.providerConfig.SDK, &resp.Diagnostics, &state)
+	userRead(ctx, d.providerConfig.SDK, &resp.Diagnostics, state)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -132,5 +133,5 @@ func (d *bindingDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		}),
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newDatasourceUser(state))...)
 }
@@ -22,6 +22,48 @@ type User struct {
 	Passwords   types.Set      `tfsdk:"passwords"`
 	ACLOptions  types.String   `tfsdk:"acl_options"`
 	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+
+	GeneratePassword         types.Bool   `tfsdk:"generate_password"`
+	OutputToLockbox          types.Object `tfsdk:"output_to_lockbox"`
+	OutputToLockboxVersionID types.String `tfsdk:"output_to_lockbox_version_id"`
+}
+
+// DatasourceUser is the data source model. Unlike User, it has no output_to_lockbox attributes.
+type DatasourceUser struct {
+	Id          types.String   `tfsdk:"id"`
+	ClusterID   types.String   `tfsdk:"cluster_id"`
+	Name        types.String   `tfsdk:"name"`
+	Permissions types.Object   `tfsdk:"permissions"`
+	Enabled     types.Bool     `tfsdk:"enabled"`
+	Passwords   types.Set      `tfsdk:"passwords"`
+	ACLOptions  types.String   `tfsdk:"acl_options"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (u *DatasourceUser) toUser() *User {
+	return &User{
+		Id:          u.Id,
+		ClusterID:   u.ClusterID,
+		Name:        u.Name,
+		Permissions: u.Permissions,
+		Enabled:     u.Enabled,
+		Passwords:   u.Passwords,
+		ACLOptions:  u.ACLOptions,
+		Timeouts:    u.Timeouts,
+	}
+}
+
+func newDatasourceUser(u *User) *DatasourceUser {
+	return &DatasourceUser{
+		Id:          u.Id,
+		ClusterID:   u.ClusterID,
+		Name:        u.Name,
+		Permissions: u.Permissions,
+		Enabled:     u.Enabled,
+		Passwords:   u.Passwords,
+		ACLOptions:  u.ACLOptions,
+		Timeouts:    u.Timeouts,
+	}
 }
 
 type Permissions struct {
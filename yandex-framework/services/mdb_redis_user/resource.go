@@ -3,6 +3,7 @@ package mdb_redis_user
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/lockboxoutput"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
@@ -34,6 +37,10 @@ const (
 	defaultName                     = "default"
 )
 
+// userSensitiveAttrs are stored into Lockbox when output_to_lockbox is supplied.
+// The passwords set holds exactly one password, which is stored as a single entry.
+var userSensitiveAttrs = []string{"passwords"}
+
 type bindingResource struct {
 	providerConfig *provider_config.Config
 }
@@ -68,7 +75,7 @@ func (r *bindingResource) Schema(ctx context.Context,
 	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Redis user within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-redis/).",
-		Attributes: map[string]schema.Attribute{
+		Attributes: lockboxoutput.ExtendWithOutputToLockbox(map[string]schema.Attribute{
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true,
 				Update: true,
@@ -97,13 +104,14 @@ func (r *bindingResource) Schema(ctx context.Context,
 			},
 			"passwords": schema.SetAttribute{
 				ElementType:         basetypes.StringType{},
-				Required:            true,
+				Optional:            true,
 				Sensitive:           true,
-				MarkdownDescription: "Set of user passwords",
+				MarkdownDescription: "Set of user passwords.\n\n~> **Must specify either passwords or generate_password**.\n",
 				Validators: []validator.Set{
 					setvalidator.SizeBetween(1, 1),
 				},
 			},
+			"generate_password": mdbcommon.GeneratePasswordAttribute("passwords"),
 			"enabled": schema.BoolAttribute{
 				MarkdownDescription: "Is redis user enabled.",
 				Optional:            true,
@@ -170,7 +178,7 @@ func (r *bindingResource) Schema(ctx context.Context,
 					},
 				},
 			},
-		},
+		}, userSensitiveAttrs, "generate_password"),
 	}
 }
 
@@ -230,9 +238,13 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 	cid := plan.ClusterID.ValueString()
 	userPlan, diags := userFromState(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	generatedPassword := mdbcommon.GeneratedPassword(types.BoolNull(), plan.GeneratePassword, lockboxoutput.NullValue(userSensitiveAttrs), plan.OutputToLockbox, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if generatedPassword != "" {
+		userPlan.Passwords = []string{generatedPassword}
+	}
 
 	createUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, userPlan)
 	if resp.Diagnostics.HasError() {
@@ -242,6 +254,7 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 	id := types.StringValue(resourceid.Construct(cid, userPlan.Name))
 	userRead(ctx, r.providerConfig.SDK, &resp.Diagnostics, &plan)
 	plan.Id = id
+	r.manageOutputToLockbox(ctx, nil, &plan, generatedPassword, &resp.Diagnostics)
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
@@ -307,9 +320,16 @@ func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	userPlan, diags := userFromState(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	generatedPassword := mdbcommon.GeneratedPassword(state.GeneratePassword, plan.GeneratePassword, state.OutputToLockbox, plan.OutputToLockbox, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if generatedPassword != "" {
+		userPlan.Passwords = []string{generatedPassword}
+		if !slices.Contains(updatePaths, "passwords") {
+			updatePaths = append(updatePaths, "passwords")
+		}
+	}
 
 	updateUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, plan.ClusterID.ValueString(), userPlan, updatePaths)
 	if resp.Diagnostics.HasError() {
@@ -317,6 +337,7 @@ func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	userRead(ctx, r.providerConfig.SDK, &resp.Diagnostics, &plan)
+	r.manageOutputToLockbox(ctx, &state, &plan, generatedPassword, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -338,13 +359,14 @@ func (r *bindingResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	cid := state.ClusterID.ValueString()
 	name := state.Name.ValueString()
-	if name == defaultName {
-		return
-	}
-	deleteUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, name)
-	if resp.Diagnostics.HasError() {
-		return
+	if name != defaultName {
+		deleteUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, name)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
+
+	resp.Diagnostics.Append(lockboxoutput.Destroy(ctx, r.providerConfig.SDK, state.OutputToLockbox, state.OutputToLockboxVersionID)...)
 }
 
 func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -363,6 +385,9 @@ func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportSt
 	}
 	var state User
 	resp.Diagnostics.Append(userToState(ctx, user, &state)...)
+	state.GeneratePassword = types.BoolValue(false)
+	state.OutputToLockbox = lockboxoutput.NullValue(userSensitiveAttrs)
+	state.OutputToLockboxVersionID = types.StringNull()
 
 	state.Timeouts = timeouts.Value{
 		Object: types.ObjectNull(map[string]attr.Type{
@@ -375,3 +400,44 @@ func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportSt
 	diags := resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
+
+// manageOutputToLockbox stores the user password into Lockbox when output_to_lockbox is supplied.
+// prior is nil when the user is being created; generatedPassword is stored instead of the configured one, if set.
+func (r *bindingResource) manageOutputToLockbox(ctx context.Context, prior, plan *User, generatedPassword string, respDiagnostics *diag.Diagnostics) {
+	priorOutput := lockboxoutput.NullValue(userSensitiveAttrs)
+	priorVersionID := types.StringNull()
+	var priorValues map[string]string
+	if prior != nil {
+		priorOutput = prior.OutputToLockbox
+		priorVersionID = prior.OutputToLockboxVersionID
+		priorValues = map[string]string{"passwords": singlePassword(ctx, prior.Passwords, respDiagnostics)}
+	}
+	password := singlePassword(ctx, plan.Passwords, respDiagnostics)
+	if respDiagnostics.HasError() {
+		return
+	}
+	if generatedPassword != "" {
+		password = generatedPassword
+	}
+
+	versionID, diags := lockboxoutput.Manage(
+		ctx, r.providerConfig.SDK, userSensitiveAttrs,
+		priorOutput, plan.OutputToLockbox, priorVersionID,
+		priorValues, map[string]string{"passwords": password},
+	)
+	respDiagnostics.Append(diags...)
+	plan.OutputToLockboxVersionID = versionID
+	if diags.HasError() {
+		// keep the prior output_to_lockbox, so that it is not recorded as applied
+		plan.OutputToLockbox = priorOutput
+	}
+}
+
+func singlePassword(ctx context.Context, passwords types.Set, diags *diag.Diagnostics) string {
+	var values []string
+	diags.Append(passwords.ElementsAs(ctx, &values, false)...)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
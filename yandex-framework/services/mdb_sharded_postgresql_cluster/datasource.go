@@ -0,0 +1,90 @@
+package mdb_sharded_postgresql_cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-sdk/sdkresolvers"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/objectid"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+var (
+	_ datasource.DataSource              = &clusterDataSource{}
+	_ datasource.DataSourceWithConfigure = &clusterDataSource{}
+)
+
+type clusterDataSource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewShardedPostgreSQLClusterDataSource() datasource.DataSource {
+	return &clusterDataSource{}
+}
+
+func (d *clusterDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_sharded_postgresql_cluster"
+}
+
+func (d *clusterDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *clusterDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = ClusterDataSourceSchema(ctx)
+}
+
+func (d *clusterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state Cluster
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Id.ValueString() == "" {
+		if state.Name.ValueString() == "" {
+			resp.Diagnostics.AddError(
+				"Failed to Read data source",
+				"one of id or name should be specified",
+			)
+			return
+		}
+
+		folderID, dg := validate.FolderID(state.FolderId, &d.providerConfig.ProviderState)
+		resp.Diagnostics.Append(dg)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		id, dg := objectid.ResolveByNameAndFolderID(ctx, d.providerConfig.SDK, folderID, state.Name.ValueString(), sdkresolvers.SPQRClusterResolver)
+		resp.Diagnostics.Append(dg)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Id = types.StringValue(id)
+	}
+
+	r := clusterResource{providerConfig: d.providerConfig}
+	r.refreshResourceState(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,220 @@
+package mdb_sharded_postgresql_cluster
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+func ClusterDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Get information about a Yandex Managed Sharded PostgreSQL cluster. For more information, see [the official documentation](https://yandex.cloud/docs/managed-spqr/).\n\n~> One of `id` or `name` should be specified.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the Sharded PostgreSQL cluster.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Sharded PostgreSQL cluster.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"folder_id": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["folder_id"],
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the Sharded PostgreSQL cluster.",
+				Computed:            true,
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["network_id"],
+				Computed:            true,
+			},
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "Deployment environment of the Sharded PostgreSQL cluster.",
+				Computed:            true,
+			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: common.ResourceDescriptions["labels"],
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"hosts": schema.MapNestedAttribute{
+				MarkdownDescription: "A host configuration of the Sharded PostgreSQL cluster.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"zone": schema.StringAttribute{
+							MarkdownDescription: "The availability zone where the host is located.",
+							Computed:            true,
+						},
+						"subnet_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the subnet where the host is located.",
+							Computed:            true,
+						},
+						"assign_public_ip": schema.BoolAttribute{
+							MarkdownDescription: "Whether the host has a public IP address.",
+							Computed:            true,
+						},
+						"fqdn": schema.StringAttribute{
+							MarkdownDescription: "The fully qualified domain name of the host.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the host.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: common.ResourceDescriptions["deletion_protection"],
+				Computed:            true,
+			},
+			"security_group_ids": schema.SetAttribute{
+				MarkdownDescription: common.ResourceDescriptions["security_group_ids"],
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"maintenance_window": schema.SingleNestedAttribute{
+				MarkdownDescription: "Maintenance policy of the Sharded PostgreSQL cluster.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of maintenance window. Can be either ANYTIME or WEEKLY.",
+						Computed:            true,
+					},
+					"day": schema.StringAttribute{
+						MarkdownDescription: "Day of the week (in DDD format).",
+						Computed:            true,
+					},
+					"hour": schema.Int64Attribute{
+						MarkdownDescription: "Hour of the day in UTC (in HH format).",
+						Computed:            true,
+					},
+				},
+			},
+			"config": schema.SingleNestedAttribute{
+				MarkdownDescription: "Configuration of the Sharded PostgreSQL cluster.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"access": schema.SingleNestedAttribute{
+						MarkdownDescription: "Access policy to the Sharded PostgreSQL cluster.",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"data_lens": schema.BoolAttribute{
+								MarkdownDescription: "Allow access for Yandex DataLens.",
+								Computed:            true,
+							},
+							"web_sql": schema.BoolAttribute{
+								MarkdownDescription: "Allow access for SQL queries in the management console",
+								Computed:            true,
+							},
+							"serverless": schema.BoolAttribute{
+								MarkdownDescription: "Allow access for connection to managed databases from functions",
+								Computed:            true,
+							},
+							"data_transfer": schema.BoolAttribute{
+								MarkdownDescription: "Allow access for DataTransfer",
+								Computed:            true,
+							},
+						},
+					},
+					"backup_retain_period_days": schema.Int64Attribute{
+						MarkdownDescription: "The period in days during which backups are stored.",
+						Computed:            true,
+					},
+					"backup_window_start": schema.SingleNestedAttribute{
+						MarkdownDescription: "Time to start the daily backup, in the UTC timezone.",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"hours": schema.Int64Attribute{
+								MarkdownDescription: "The hour at which backup will be started (UTC).",
+								Computed:            true,
+							},
+							"minutes": schema.Int64Attribute{
+								MarkdownDescription: "The minute at which backup will be started (UTC).",
+								Computed:            true,
+							},
+						},
+					},
+					"sharded_postgresql_config": schema.SingleNestedAttribute{
+						MarkdownDescription: "Sharded PostgreSQL cluster configuration.",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"common": dataSourceSettingsSchema("General settings for all types of hosts."),
+							"router": schema.SingleNestedAttribute{
+								MarkdownDescription: "Router specific configuration.",
+								Computed:            true,
+								Attributes: map[string]schema.Attribute{
+									"config":    dataSourceSettingsSchema("Router settings."),
+									"resources": dataSourceResourcesSchema("Resources allocated to routers of the Sharded PostgreSQL cluster."),
+								},
+							},
+							"coordinator": schema.SingleNestedAttribute{
+								MarkdownDescription: "Coordinator specific configuration.",
+								Computed:            true,
+								Attributes: map[string]schema.Attribute{
+									"config":    dataSourceSettingsSchema("Coordinator settings."),
+									"resources": dataSourceResourcesSchema("Resources allocated to coordinators of the Sharded PostgreSQL cluster."),
+								},
+							},
+							"infra": schema.SingleNestedAttribute{
+								MarkdownDescription: "Configuration of infra hosts, which run both router and coordinator.",
+								Computed:            true,
+								Attributes: map[string]schema.Attribute{
+									"router":      dataSourceSettingsSchema("Router settings."),
+									"coordinator": dataSourceSettingsSchema("Coordinator settings."),
+									"resources":   dataSourceResourcesSchema("Resources allocated to infra hosts of the Sharded PostgreSQL cluster."),
+								},
+							},
+							"balancer": dataSourceSettingsSchema("Balancer specific configuration."),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSettingsSchema(description string) schema.MapAttribute {
+	return schema.MapAttribute{
+		CustomType:          mdbcommon.NewSettingsMapType(attrProvider),
+		MarkdownDescription: description,
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
+}
+
+func dataSourceResourcesSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"resource_preset_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource preset that determines the number of CPU cores and memory size for the host.",
+				Computed:            true,
+			},
+			"disk_type_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the disk type that determines the disk performance characteristics.",
+				Computed:            true,
+			},
+			"disk_size": schema.Int64Attribute{
+				MarkdownDescription: "Size of the disk in bytes.",
+				Computed:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,38 @@
+package mdb_sharded_postgresql_database_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+)
+
+const dbDataSourceNameTestdb = "data.yandex_mdb_sharded_postgresql_database.testdb"
+
+func TestAccDataSourceMDBShardedPostgreSQLDatabase_basic(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("ds-sharded_postgresql-database")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMDBShardedPostgreSQLDatabaseConfig(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dbDataSourceNameTestdb, "id", dbResourceNameTestdb, "id"),
+					resource.TestCheckResourceAttrPair(dbDataSourceNameTestdb, "cluster_id", dbResourceNameTestdb, "cluster_id"),
+					resource.TestCheckResourceAttr(dbDataSourceNameTestdb, "name", "testdb"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMDBShardedPostgreSQLDatabaseConfig(name string) string {
+	return testAccMDBShardedPostgreSQLDatabaseConfigStep1(name) + `
+data "yandex_mdb_sharded_postgresql_database" "testdb" {
+	cluster_id = yandex_mdb_sharded_postgresql_database.testdb.cluster_id
+	name       = yandex_mdb_sharded_postgresql_database.testdb.name
+}`
+}
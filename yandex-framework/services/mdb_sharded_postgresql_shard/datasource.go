@@ -0,0 +1,95 @@
+package mdb_sharded_postgresql_shard
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+type bindingDataSource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewShardedPostgreSQLShardDataSource() datasource.DataSource {
+	return &bindingDataSource{}
+}
+
+func (d *bindingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_sharded_postgresql_shard"
+}
+
+func (d *bindingDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *bindingDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get information about a Sharded PostgreSQL shard within the Yandex Cloud.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["id"],
+				Computed:            true,
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the Sharded PostgreSQL cluster.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Sharded PostgreSQL shard.",
+				Required:            true,
+			},
+			"shard_spec": schema.SingleNestedAttribute{
+				MarkdownDescription: "Shard specification.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"mdb_postgresql": schema.StringAttribute{
+						MarkdownDescription: "ID of the Managed PostgreSQL cluster that backs the shard.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *bindingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state Shard
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := state.ClusterID.ValueString()
+	shardName := state.Name.ValueString()
+	shard := shardedPostgreSQLAPI.ReadShard(ctx, d.providerConfig.SDK, &resp.Diagnostics, cid, shardName)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(shardToState(shard, &state, cid)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Id = types.StringValue(resourceid.Construct(cid, shardName))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
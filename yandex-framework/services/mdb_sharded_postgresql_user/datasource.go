@@ -0,0 +1,122 @@
+package mdb_sharded_postgresql_user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+type DataSourceUser struct {
+	Id          types.String               `tfsdk:"id"`
+	ClusterID   types.String               `tfsdk:"cluster_id"`
+	Name        types.String               `tfsdk:"name"`
+	Grants      types.Set                  `tfsdk:"grants"`
+	Permissions types.Set                  `tfsdk:"permissions"`
+	Settings    mdbcommon.SettingsMapValue `tfsdk:"settings"`
+}
+
+type bindingDataSource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewShardedPostgreSQLUserDataSource() datasource.DataSource {
+	return &bindingDataSource{}
+}
+
+func (d *bindingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_sharded_postgresql_user"
+}
+
+func (d *bindingDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *bindingDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get information about a Sharded PostgreSQL user within the Yandex Cloud.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["id"],
+				Computed:            true,
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the Sharded PostgreSQL cluster.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Sharded PostgreSQL user.",
+				Required:            true,
+			},
+			"grants": schema.SetAttribute{
+				MarkdownDescription: "Roles granted to the user.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"permissions": schema.SetNestedAttribute{
+				MarkdownDescription: "Databases that are permitted to the user.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"database": schema.StringAttribute{
+							MarkdownDescription: "Name of the database that the permission grants access to.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"settings": schema.MapAttribute{
+				MarkdownDescription: "Settings of the user.",
+				CustomType:          mdbcommon.NewSettingsMapType(attrProvider),
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *bindingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DataSourceUser
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := state.ClusterID.ValueString()
+	userName := state.Name.ValueString()
+	user := shardedPostgreSQLAPI.ReadUser(ctx, d.providerConfig.SDK, &resp.Diagnostics, cid, userName)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var u User
+	resp.Diagnostics.Append(userToState(ctx, user, &u)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Id = types.StringValue(resourceid.Construct(cid, userName))
+	state.Grants = u.Grants
+	state.Permissions = u.Permissions
+	state.Settings = u.Settings
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
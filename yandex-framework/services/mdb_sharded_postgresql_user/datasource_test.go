@@ -0,0 +1,39 @@
+package mdb_sharded_postgresql_user_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+)
+
+const userDataSourceNameAlice = "data.yandex_mdb_sharded_postgresql_user.alice"
+
+func TestAccDataSourceMDBShardedPostgreSQLUser_basic(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("ds-sharded_postgresql-user")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMDBShardedPostgreSQLUserConfig(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(userDataSourceNameAlice, "id", userResourceNameAlice, "id"),
+					resource.TestCheckResourceAttr(userDataSourceNameAlice, "name", "alice"),
+					resource.TestCheckResourceAttr(userDataSourceNameAlice, "grants.#", "2"),
+					resource.TestCheckResourceAttr(userDataSourceNameAlice, "settings.connection_limit", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMDBShardedPostgreSQLUserConfig(name string) string {
+	return testAccMDBShardedPostgreSQLUserConfigStep1(name) + `
+data "yandex_mdb_sharded_postgresql_user" "alice" {
+	cluster_id = yandex_mdb_sharded_postgresql_user.alice.cluster_id
+	name       = yandex_mdb_sharded_postgresql_user.alice.name
+}`
+}
@@ -20,6 +20,10 @@ type User struct {
 	Permissions types.Set                  `tfsdk:"permissions"`
 	Settings    mdbcommon.SettingsMapValue `tfsdk:"settings"`
 	Timeouts    timeouts.Value             `tfsdk:"timeouts"`
+
+	GeneratePassword         types.Bool   `tfsdk:"generate_password"`
+	OutputToLockbox          types.Object `tfsdk:"output_to_lockbox"`
+	OutputToLockboxVersionID types.String `tfsdk:"output_to_lockbox_version_id"`
 }
 
 type Permission struct {
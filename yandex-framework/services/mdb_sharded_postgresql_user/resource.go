@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -12,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/lockboxoutput"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 )
@@ -114,9 +117,13 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 	log.Printf("[DEBUG] User spec from state: %v\n", userSpec)
 
 	resp.Diagnostics.Append(diags...)
+	generatedPassword := mdbcommon.GeneratedPassword(types.BoolNull(), plan.GeneratePassword, lockboxoutput.NullValue(userSensitiveAttrs), plan.OutputToLockbox, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if generatedPassword != "" {
+		userSpec.Password = generatedPassword
+	}
 
 	shardedPostgreSQLAPI.CreateUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, userSpec)
 	if resp.Diagnostics.HasError() {
@@ -129,6 +136,7 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	r.manageOutputToLockbox(ctx, nil, &plan, generatedPassword, &resp.Diagnostics)
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
@@ -171,25 +179,32 @@ func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest
 	cid := plan.ClusterID.ValueString()
 	userPlan, diags := userFromState(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	generatedPassword := mdbcommon.GeneratedPassword(state.GeneratePassword, plan.GeneratePassword, state.OutputToLockbox, plan.OutputToLockbox, &resp.Diagnostics)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	updatePaths := getUpdatePaths(&plan, &state)
-
-	if len(updatePaths) == 0 {
-		return
+	if generatedPassword != "" {
+		userPlan.Password = generatedPassword
+		if !slices.Contains(updatePaths, "password") {
+			updatePaths = append(updatePaths, "password")
+		}
 	}
-
 	userName := plan.Name.ValueString()
-	log.Printf("[DEBUG] Updating user %v with update_mask %v", userName, updatePaths)
 
-	shardedPostgreSQLAPI.UpdateUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, userPlan, updatePaths)
-	if resp.Diagnostics.HasError() {
-		return
+	if len(updatePaths) > 0 {
+		log.Printf("[DEBUG] Updating user %v with update_mask %v", userName, updatePaths)
+
+		shardedPostgreSQLAPI.UpdateUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, userPlan, updatePaths)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		r.refreshResourceState(ctx, &plan, &resp.Diagnostics)
 	}
 
-	r.refreshResourceState(ctx, &plan, &resp.Diagnostics)
+	r.manageOutputToLockbox(ctx, &state, &plan, generatedPassword, &resp.Diagnostics)
 	plan.Id = types.StringValue(resourceid.Construct(cid, userName))
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -214,6 +229,11 @@ func (r *bindingResource) Delete(ctx context.Context, req resource.DeleteRequest
 	cid := state.ClusterID.ValueString()
 	userName := state.Name.ValueString()
 	shardedPostgreSQLAPI.DeleteUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, userName)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(lockboxoutput.Destroy(ctx, r.providerConfig.SDK, state.OutputToLockbox, state.OutputToLockboxVersionID)...)
 }
 
 func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -234,6 +254,9 @@ func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportSt
 	var state User
 
 	resp.Diagnostics.Append(userToState(ctx, user, &state)...)
+	state.GeneratePassword = types.BoolValue(false)
+	state.OutputToLockbox = lockboxoutput.NullValue(userSensitiveAttrs)
+	state.OutputToLockboxVersionID = types.StringNull()
 
 	state.Timeouts = timeouts.Value{
 		Object: types.ObjectNull(map[string]attr.Type{
@@ -247,6 +270,36 @@ func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportSt
 	resp.Diagnostics.Append(diags...)
 }
 
+// manageOutputToLockbox stores the user password into Lockbox when output_to_lockbox is supplied.
+// prior is nil when the user is being created; generatedPassword is stored instead of the configured one, if set.
+func (r *bindingResource) manageOutputToLockbox(ctx context.Context, prior, plan *User, generatedPassword string, respDiagnostics *diag.Diagnostics) {
+	priorOutput := lockboxoutput.NullValue(userSensitiveAttrs)
+	priorVersionID := types.StringNull()
+	var priorValues map[string]string
+	if prior != nil {
+		priorOutput = prior.OutputToLockbox
+		priorVersionID = prior.OutputToLockboxVersionID
+		priorValues = map[string]string{"password": prior.Password.ValueString()}
+	}
+
+	password := plan.Password.ValueString()
+	if generatedPassword != "" {
+		password = generatedPassword
+	}
+
+	versionID, diags := lockboxoutput.Manage(
+		ctx, r.providerConfig.SDK, userSensitiveAttrs,
+		priorOutput, plan.OutputToLockbox, priorVersionID,
+		priorValues, map[string]string{"password": password},
+	)
+	respDiagnostics.Append(diags...)
+	plan.OutputToLockboxVersionID = versionID
+	if diags.HasError() {
+		// keep the prior output_to_lockbox, so that it is not recorded as applied
+		plan.OutputToLockbox = priorOutput
+	}
+}
+
 func (r *bindingResource) refreshResourceState(ctx context.Context, state *User, respDiagnostics *diag.Diagnostics) {
 	tfsdklog.Debug(ctx, fmt.Sprintf("refreshing state: %v", *state))
 	cid := state.ClusterID.ValueString()
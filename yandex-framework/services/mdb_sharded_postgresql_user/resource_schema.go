@@ -10,13 +10,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/lockboxoutput"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
 )
 
+// userSensitiveAttrs are stored into Lockbox when output_to_lockbox is supplied.
+var userSensitiveAttrs = []string{"password"}
+
 func UserSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "Manages a Sharded PostgreSQL user within the Yandex.Cloud",
-		Attributes: map[string]schema.Attribute{
+		Attributes: lockboxoutput.ExtendWithOutputToLockbox(map[string]schema.Attribute{
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true,
 				Update: true,
@@ -44,13 +48,14 @@ func UserSchema(ctx context.Context) schema.Schema {
 				},
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "Password of the Sharded PostgreSQL user. Provided by the client when the user is created.",
+				MarkdownDescription: "Password of the Sharded PostgreSQL user. Provided by the client when the user is created.\n\n~> **Must specify either password or generate_password**.\n",
 				Optional:            true,
 				Sensitive:           true,
 			},
-			"settings": SettingsSchema(),
-			"grants":   GrantsSchema(),
-		},
+			"generate_password": mdbcommon.GeneratePasswordAttribute("password"),
+			"settings":          SettingsSchema(),
+			"grants":            GrantsSchema(),
+		}, userSensitiveAttrs, "generate_password"),
 		Blocks: map[string]schema.Block{
 			"permissions": PermissionSchema(),
 		},
@@ -3,9 +3,15 @@ package yandex
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/cdn/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
 )
 
 func prepareCDNResourceOriginProtocol(d *schema.ResourceData) cdn.OriginProtocol {
@@ -88,3 +94,85 @@ func cdnCheckProviderMatching(ctx context.Context, req *cdn.CreateResourceReques
 	}
 	return nil
 }
+
+var validateCDNCachePath = validation.StringMatch(regexp.MustCompile(`^/`), "path must start with '/'")
+
+// validateCDNCachePurgeAll rejects purge_all = false, which would purge the whole cache as well.
+func validateCDNCachePurgeAll(v interface{}, k string) ([]string, []error) {
+	if purgeAll, ok := v.(bool); ok && !purgeAll {
+		return nil, []error{fmt.Errorf("%s must be true if set, use paths to purge only some files", k)}
+	}
+	return nil, nil
+}
+
+// cdnCacheActionSchema returns the attributes shared by the CDN cache purge and prefetch resources.
+// All of them force a new resource, so that a change of the paths or triggers runs the action again.
+func cdnCacheActionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"resource_id": {
+			Type:        schema.TypeString,
+			Description: "ID of the CDN resource.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"triggers": {
+			Type:        schema.TypeMap,
+			Description: "Arbitrary map of values. When any of them is changed, the action is run again. For example, use the `source_hash` of the uploaded `yandex_storage_object` resources to run it after every deploy.",
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"operation_id": {
+			Type:        schema.TypeString,
+			Description: "ID of the last operation that ran the action.",
+			Computed:    true,
+		},
+	}
+}
+
+// cdnCacheOperationError adds the details of a failed cache operation, such as the paths that were not processed,
+// to the error message.
+func cdnCacheOperationError(action, resourceID string, err error) error {
+	grpcStatus, ok := status.FromError(err)
+	if !ok || len(grpcStatus.Details()) == 0 {
+		return fmt.Errorf("error while %s cache of CDN resource %q: %w", action, resourceID, err)
+	}
+
+	var details []string
+	for _, detail := range grpcStatus.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.BadRequest:
+			for _, violation := range detail.GetFieldViolations() {
+				details = append(details, fmt.Sprintf("%s: %s", violation.GetField(), violation.GetDescription()))
+			}
+		case *errdetails.ErrorInfo:
+			keys := make([]string, 0, len(detail.GetMetadata()))
+			for k := range detail.GetMetadata() {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				details = append(details, fmt.Sprintf("%s: %s", k, detail.GetMetadata()[k]))
+			}
+		case *errdetails.RequestInfo:
+			// the request ID does not describe the failure
+		default:
+			details = append(details, fmt.Sprintf("%v", detail))
+		}
+	}
+	if len(details) == 0 {
+		return fmt.Errorf("error while %s cache of CDN resource %q: %w", action, resourceID, err)
+	}
+	return fmt.Errorf("error while %s cache of CDN resource %q: %w\n%s", action, resourceID, err, strings.Join(details, "\n"))
+}
+
+// resourceYandexCDNCacheActionRead keeps the state as is, since a completed cache operation has nothing to refresh.
+func resourceYandexCDNCacheActionRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+// resourceYandexCDNCacheActionDelete only removes the resource from the state, since cache operations can't be undone.
+func resourceYandexCDNCacheActionDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
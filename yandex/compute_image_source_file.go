@@ -0,0 +1,233 @@
+package yandex
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+const (
+	computeImageStagingPrefix    = "terraform-image-staging/"
+	computeImageStagingURLExpire = time.Hour
+)
+
+// computeImageFileHash returns the SHA256 checksum of the file and its size, reading it as a stream.
+func computeImageFileHash(filename string) (string, int64, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), n, nil
+}
+
+// resourceYandexComputeImageCustomizeDiffSourceFile plans source_file_hash, so that the image is recreated when the file is changed.
+// The file of an existing image is only hashed again when its size or modification time differ from the uploaded one.
+func resourceYandexComputeImageCustomizeDiffSourceFile(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if _, ok := diff.GetOk("source_file"); !ok {
+		return nil
+	}
+
+	if !diff.NewValueKnown("source_file") {
+		if err := checkComputeImageStagingKeys(diff, meta); err != nil {
+			return err
+		}
+		return setNewComputedSourceFile(diff)
+	}
+
+	filename := diff.Get("source_file").(string)
+	info, err := os.Stat(filename)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			if diff.Id() != "" {
+				// the file of an existing image may be removed after upload, the stored hash is kept
+				return nil
+			}
+			if err := checkComputeImageStagingKeys(diff, meta); err != nil {
+				return err
+			}
+			// the file may be created by another resource during apply
+			return setNewComputedSourceFile(diff)
+		}
+		return fmt.Errorf("Cannot read source_file of image: %s", err)
+	}
+
+	if diff.Id() != "" && !diff.HasChange("source_file") &&
+		info.Size() == int64(diff.Get("source_file_size").(int)) &&
+		formatComputeImageFileModTime(info.ModTime()) == diff.Get("source_file_modified_at").(string) {
+		return nil
+	}
+
+	hash, _, err := computeImageFileHash(filename)
+	if err != nil {
+		return fmt.Errorf("Cannot read source_file of image: %s", err)
+	}
+
+	if hash == diff.Get("source_file_hash").(string) {
+		return nil
+	}
+	if err := checkComputeImageStagingKeys(diff, meta); err != nil {
+		return err
+	}
+	if err := diff.SetNew("source_file_hash", hash); err != nil {
+		return err
+	}
+	if err := setNewComputedSourceFileInfo(diff); err != nil {
+		return err
+	}
+	if diff.Id() != "" {
+		return diff.ForceNew("source_file_hash")
+	}
+	return nil
+}
+
+func setNewComputedSourceFile(diff *schema.ResourceDiff) error {
+	if err := diff.SetNewComputed("source_file_hash"); err != nil {
+		return err
+	}
+	return setNewComputedSourceFileInfo(diff)
+}
+
+func setNewComputedSourceFileInfo(diff *schema.ResourceDiff) error {
+	if err := diff.SetNewComputed("source_file_size"); err != nil {
+		return err
+	}
+	return diff.SetNewComputed("source_file_modified_at")
+}
+
+// checkComputeImageStagingKeys fails the plan if the file has to be uploaded without static access keys:
+// the image is created from a presigned URL of the staged object, which can't be signed with an IAM token.
+func checkComputeImageStagingKeys(diff *schema.ResourceDiff, meta interface{}) error {
+	config, ok := meta.(*Config)
+	if !ok {
+		return nil
+	}
+	if !diff.NewValueKnown("access_key") || !diff.NewValueKnown("secret_key") {
+		return nil
+	}
+	if diff.Get("access_key").(string) != "" && diff.Get("secret_key").(string) != "" {
+		return nil
+	}
+	if accessKey, secretKey := config.resolveStorageAccessKeys(); accessKey != "" && secretKey != "" {
+		return nil
+	}
+	return fmt.Errorf("source_file requires static access keys to upload the file to staging_bucket: " +
+		"set access_key and secret_key of the image, or storage_access_key and storage_secret_key of the provider")
+}
+
+func formatComputeImageFileModTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// prepareSourceFileForImage uploads source_file to the staging bucket and sets a presigned URL of the object as the image source.
+// The returned cleanup function removes the staged object and must be called after the image is created.
+func prepareSourceFileForImage(ctx context.Context, req *compute.CreateImageRequest, d *schema.ResourceData, config *Config) (func(), error) {
+	filename := d.Get("source_file").(string)
+	bucket := d.Get("staging_bucket").(string)
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open source_file: %s", err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("cannot open source_file: %s", err)
+	}
+
+	s3Client, err := getS3Client(ctx, d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	// The object is named after the planned hash, which is verified after upload.
+	hash := d.Get("source_file_hash").(string)
+	if hash == "" {
+		if hash, _, err = computeImageFileHash(filename); err != nil {
+			return nil, fmt.Errorf("cannot read source_file: %s", err)
+		}
+	}
+	key := computeImageStagingPrefix + hash
+
+	h := sha256.New()
+	counter := &countingReader{r: io.TeeReader(f, h)}
+
+	log.Printf("[DEBUG] Uploading image file %q to bucket %q as %q", filename, bucket, key)
+	if err := s3Client.UploadObject(ctx, bucket, key, counter); err != nil {
+		return nil, err
+	}
+
+	cleanup := func() {
+		log.Printf("[DEBUG] Deleting staged image file %q from bucket %q", key, bucket)
+		if err := s3Client.DeleteObject(ctx, bucket, key); err != nil {
+			log.Printf("[WARN] Failed to delete staged image file %q from bucket %q: %s", key, bucket, err)
+		}
+	}
+
+	if uploaded := hex.EncodeToString(h.Sum(nil)); uploaded != hash {
+		cleanup()
+		return nil, fmt.Errorf("source_file %q was changed since plan: expected SHA256 %s, uploaded %s", filename, hash, uploaded)
+	}
+
+	size, err := s3Client.GetObjectSize(ctx, bucket, key)
+	if err != nil {
+		cleanup()
+		return nil, err
+	}
+	if size != counter.n {
+		cleanup()
+		return nil, fmt.Errorf("staged image file %q has size %d, expected %d", key, size, counter.n)
+	}
+
+	url, err := s3Client.PresignGetObject(bucket, key, computeImageStagingURLExpire)
+	if err != nil {
+		cleanup()
+		return nil, err
+	}
+
+	req.Source = &compute.CreateImageRequest_Uri{
+		Uri: url,
+	}
+
+	if err := d.Set("source_file_hash", hash); err != nil {
+		cleanup()
+		return nil, err
+	}
+	if err := d.Set("source_file_size", int(counter.n)); err != nil {
+		cleanup()
+		return nil, err
+	}
+	if err := d.Set("source_file_modified_at", formatComputeImageFileModTime(info.ModTime())); err != nil {
+		cleanup()
+		return nil, err
+	}
+
+	return cleanup, nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,130 @@
+package yandex
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeImageFileHash(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "image.qcow2")
+	require.NoError(t, os.WriteFile(filename, []byte("image"), 0o600))
+
+	hash, size, err := computeImageFileHash(filename)
+	require.NoError(t, err)
+	assert.Equal(t, "6105d6cc76af400325e94d588ce511be5bfdbb73b437dc51eca43917d7a43e3d", hash)
+	assert.Equal(t, int64(5), size)
+
+	_, _, err = computeImageFileHash(filepath.Join(t.TempDir(), "missing.qcow2"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestCountingReader(t *testing.T) {
+	r := &countingReader{r: strings.NewReader("some image data")}
+	buf := make([]byte, 4)
+	for {
+		if _, err := r.Read(buf); err != nil {
+			break
+		}
+	}
+	assert.Equal(t, int64(15), r.n)
+}
+
+func TestComputeImageCustomizeDiffMissingSourceFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "missing.qcow2")
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"source_file":    filename,
+		"staging_bucket": "images",
+	})
+
+	// the file may be created during apply, the hash of a new image is unknown
+	diff, err := resourceYandexComputeImage().SimpleDiff(context.Background(), nil, config, nil)
+	require.NoError(t, err)
+	assert.True(t, diff.Attributes["source_file_hash"].NewComputed)
+
+	// the file of an existing image may be removed after upload, the stored hash is kept
+	state := &terraform.InstanceState{
+		ID: "fd8image",
+		Attributes: map[string]string{
+			"id":               "fd8image",
+			"source_file":      filename,
+			"staging_bucket":   "images",
+			"source_file_hash": "6105d6cc76af400325e94d588ce511be5bfdbb73b437dc51eca43917d7a43e3d",
+		},
+	}
+	diff, err = resourceYandexComputeImage().SimpleDiff(context.Background(), state, config, nil)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	assert.NotContains(t, diff.Attributes, "source_file_hash")
+}
+
+func TestComputeImageCustomizeDiffSourceFileKeys(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "image.qcow2")
+	require.NoError(t, os.WriteFile(filename, []byte("image"), 0o600))
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"source_file":    filename,
+		"staging_bucket": "images",
+	})
+
+	// presigned URLs can't be created with an IAM token
+	_, err := resourceYandexComputeImage().SimpleDiff(context.Background(), nil, config, &Config{})
+	assert.ErrorContains(t, err, "static access keys")
+
+	diff, err := resourceYandexComputeImage().SimpleDiff(context.Background(), nil, config, &Config{
+		StorageAccessKey: "access",
+		StorageSecretKey: "secret",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "6105d6cc76af400325e94d588ce511be5bfdbb73b437dc51eca43917d7a43e3d", diff.Attributes["source_file_hash"].New)
+
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"source_file":    filename,
+		"staging_bucket": "images",
+		"access_key":     "access",
+		"secret_key":     "secret",
+	})
+	_, err = resourceYandexComputeImage().SimpleDiff(context.Background(), nil, config, &Config{})
+	require.NoError(t, err)
+}
+
+func TestComputeImageCustomizeDiffUnchangedSourceFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "image.qcow2")
+	require.NoError(t, os.WriteFile(filename, []byte("image"), 0o600))
+	info, err := os.Stat(filename)
+	require.NoError(t, err)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"source_file":    filename,
+		"staging_bucket": "images",
+	})
+	state := &terraform.InstanceState{
+		ID: "fd8image",
+		Attributes: map[string]string{
+			"id":             "fd8image",
+			"source_file":    filename,
+			"staging_bucket": "images",
+			// the stored hash is not compared while the size and modification time are the same
+			"source_file_hash":        "stale",
+			"source_file_size":        "5",
+			"source_file_modified_at": formatComputeImageFileModTime(info.ModTime()),
+		},
+	}
+	diff, err := resourceYandexComputeImage().SimpleDiff(context.Background(), state, config, nil)
+	require.NoError(t, err)
+	if diff != nil {
+		assert.NotContains(t, diff.Attributes, "source_file_hash")
+	}
+
+	state.Attributes["source_file_size"] = "4"
+	diff, err = resourceYandexComputeImage().SimpleDiff(context.Background(), state, config, nil)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	assert.True(t, diff.Attributes["source_file_hash"].RequiresNew)
+}
@@ -0,0 +1,117 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1/instancegroup"
+)
+
+// waitInstanceGroupRollout waits until the instance group has reached its target size with all instances
+// running the actual template, if the wait_for_rollout block is set.
+func waitInstanceGroupRollout(config *Config, d *schema.ResourceData) error {
+	if _, ok := d.GetOk("wait_for_rollout"); !ok {
+		return nil
+	}
+
+	timeout, err := time.ParseDuration(d.Get("wait_for_rollout.0.timeout").(string))
+	if err != nil {
+		return fmt.Errorf("cannot parse wait_for_rollout timeout: %w", err)
+	}
+	checkInterval, err := time.ParseDuration(d.Get("wait_for_rollout.0.check_interval").(string))
+	if err != nil {
+		return fmt.Errorf("cannot parse wait_for_rollout check_interval: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(config.Context(), timeout)
+	defer cancel()
+
+	for {
+		instanceGroup, err := config.sdk.InstanceGroup().InstanceGroup().Get(ctx, &instancegroup.GetInstanceGroupRequest{
+			InstanceGroupId: d.Id(),
+		})
+		if err != nil {
+			return fmt.Errorf("Error while waiting for rollout of Instance group %q: %s", d.Id(), err)
+		}
+
+		managedInstances, err := listInstanceGroupManagedInstances(ctx, config, d.Id())
+		if err != nil {
+			return fmt.Errorf("Error while waiting for rollout of Instance group %q: %s", d.Id(), err)
+		}
+
+		if instanceGroupRolloutDone(instanceGroup.GetManagedInstancesState(), managedInstances) {
+			log.Printf("[DEBUG] Rollout of Instance group %q is complete", d.Id())
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			instances, err := flattenInstanceGroupManagedInstances(managedInstances)
+			if err != nil {
+				return err
+			}
+			return fmt.Errorf("Instance group %q rollout did not complete in %s (%d of %d instances are RUNNING_ACTUAL):\n%s",
+				d.Id(), timeout, instanceGroup.GetManagedInstancesState().GetRunningActualCount(),
+				instanceGroup.GetManagedInstancesState().GetTargetSize(), instanceGroupRolloutStatusMessage(instances))
+		case <-time.After(checkInterval):
+		}
+	}
+}
+
+func listInstanceGroupManagedInstances(ctx context.Context, config *Config, instanceGroupID string) ([]*instancegroup.ManagedInstance, error) {
+	var instances []*instancegroup.ManagedInstance
+	var token string
+	for {
+		resp, err := config.sdk.InstanceGroup().InstanceGroup().ListInstances(ctx, &instancegroup.ListInstanceGroupInstancesRequest{
+			InstanceGroupId: instanceGroupID,
+			PageSize:        defaultListSize,
+			PageToken:       token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, resp.Instances...)
+
+		token = resp.NextPageToken
+		if token == "" {
+			return instances, nil
+		}
+	}
+}
+
+func instanceGroupRolloutDone(state *instancegroup.ManagedInstancesState, instances []*instancegroup.ManagedInstance) bool {
+	if state.GetProcessingCount() > 0 || state.GetRunningOutdatedCount() > 0 {
+		return false
+	}
+	if int64(len(instances)) != state.GetTargetSize() {
+		return false
+	}
+	for _, instance := range instances {
+		if instance.GetStatus() != instancegroup.ManagedInstance_RUNNING_ACTUAL {
+			return false
+		}
+	}
+	return true
+}
+
+// instanceGroupRolloutStatusMessage lists the instances that are not RUNNING_ACTUAL, one per line.
+func instanceGroupRolloutStatusMessage(instances []map[string]interface{}) string {
+	var lines []string
+	for _, instance := range instances {
+		status := instance["status"].(string)
+		if status == instancegroup.ManagedInstance_RUNNING_ACTUAL.String() {
+			continue
+		}
+
+		line := fmt.Sprintf("  %s (%s): %s", instance["name"], instance["instance_id"], status)
+		if msg := instance["status_message"].(string); msg != "" {
+			line += ": " + msg
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,44 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1/instancegroup"
+)
+
+func TestInstanceGroupRolloutDone(t *testing.T) {
+	actual := &instancegroup.ManagedInstance{Status: instancegroup.ManagedInstance_RUNNING_ACTUAL}
+	checking := &instancegroup.ManagedInstance{Status: instancegroup.ManagedInstance_CHECKING_HEALTH}
+
+	assert.True(t, instanceGroupRolloutDone(
+		&instancegroup.ManagedInstancesState{TargetSize: 2, RunningActualCount: 2},
+		[]*instancegroup.ManagedInstance{actual, actual},
+	))
+	assert.False(t, instanceGroupRolloutDone(
+		&instancegroup.ManagedInstancesState{TargetSize: 2, RunningActualCount: 1, ProcessingCount: 1},
+		[]*instancegroup.ManagedInstance{actual, checking},
+	))
+	assert.False(t, instanceGroupRolloutDone(
+		&instancegroup.ManagedInstancesState{TargetSize: 3, RunningActualCount: 2},
+		[]*instancegroup.ManagedInstance{actual, actual},
+	))
+	assert.False(t, instanceGroupRolloutDone(
+		&instancegroup.ManagedInstancesState{TargetSize: 1, RunningOutdatedCount: 1},
+		[]*instancegroup.ManagedInstance{{Status: instancegroup.ManagedInstance_RUNNING_OUTDATED}},
+	))
+}
+
+func TestInstanceGroupRolloutStatusMessage(t *testing.T) {
+	instances, err := flattenInstanceGroupManagedInstances([]*instancegroup.ManagedInstance{
+		{Name: "ig-1", InstanceId: "id1", Status: instancegroup.ManagedInstance_RUNNING_ACTUAL},
+		{Name: "ig-2", InstanceId: "id2", Status: instancegroup.ManagedInstance_CHECKING_HEALTH, StatusMessage: "Health check failed"},
+		{Name: "ig-3", InstanceId: "id3", Status: instancegroup.ManagedInstance_CREATING_INSTANCE},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t,
+		"  ig-2 (id2): CHECKING_HEALTH: Health check failed\n  ig-3 (id3): CREATING_INSTANCE",
+		instanceGroupRolloutStatusMessage(instances),
+	)
+}
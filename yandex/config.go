@@ -27,18 +27,10 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/config"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/iamtoken"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
 )
 
-type iamToken struct {
-	Token     string
-	expiresAt time.Time
-}
-
-func (t iamToken) IsValid() bool {
-	return t.Token != "" && t.expiresAt.After(time.Now())
-}
-
 type Config struct {
 	Endpoint                       string
 	FolderID                       string
@@ -75,7 +67,7 @@ type Config struct {
 	sdk               *ycsdk.SDK
 	sharedCredentials *SharedCredentials
 	defaultS3Client   *s3.Client
-	iamToken          *iamToken
+	iamTokenSource    *iamtoken.Source
 }
 
 // this function return context with added client trace id
@@ -152,6 +144,8 @@ func (c *Config) initAndValidate(stopContext context.Context, terraformVersion s
 	if err != nil {
 		return err
 	}
+	// the framework provider is configured with the same credentials and shares the token
+	c.iamTokenSource = iamtoken.Shared(iamtoken.CredentialsKey(c.Endpoint, c.Token, c.ServiceAccountKeyFileOrContent), c.sdk.CreateIAMToken)
 
 	err = c.initSharedCredentials()
 	if err != nil {
@@ -188,13 +182,14 @@ func (c *Config) initializeDefaultS3Client(ctx context.Context) (err error) {
 	}
 
 	accessKey, secretKey := c.resolveStorageAccessKeys()
-	iamToken, err := c.getIAMToken(ctx)
-	if err != nil {
+	var iamToken s3.IAMTokenFunc
+	if _, err := c.getIAMToken(ctx); err != nil {
 		log.Println("[WARN] Failed to get IAM token for default storage client:", err)
-		iamToken = ""
+	} else {
+		iamToken = c.getIAMToken
 	}
 
-	if (accessKey == "" || secretKey == "") && iamToken == "" {
+	if (accessKey == "" || secretKey == "") && iamToken == nil {
 		return nil
 	}
 
@@ -232,24 +227,14 @@ func (c *Config) credentials() (ycsdk.Credentials, error) {
 	)
 }
 
+// getIAMToken returns the IAM token of the provider credentials. The token is cached and shared by
+// the clients that can't use the SDK credentials, such as the YDB and storage clients.
 func (c *Config) getIAMToken(ctx context.Context) (string, error) {
-	if c.iamToken != nil && c.iamToken.IsValid() {
-		return c.iamToken.Token, nil
-	}
-
-	resp, err := c.sdk.CreateIAMToken(ctx)
-	if err != nil {
-		return "", fmt.Errorf("failed to get IAM token: %w", err)
-	}
-
-	c.iamToken = &iamToken{
-		Token: resp.IamToken,
-	}
-	if resp.ExpiresAt != nil && resp.ExpiresAt.IsValid() {
-		c.iamToken.expiresAt = resp.ExpiresAt.AsTime()
+	// the source is created once in initAndValidate, so that concurrent operations share it
+	if c.iamTokenSource == nil {
+		return "", fmt.Errorf("IAM token source is not initialized")
 	}
-
-	return c.iamToken.Token, nil
+	return c.iamTokenSource.Token(ctx)
 }
 
 func iamKeyFromJSONContent(content string) (*iamkey.Key, error) {
@@ -0,0 +1,251 @@
+package yandex
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+func dataSourceYandexComputeSnapshots() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get a list of Yandex Compute snapshots in a folder, filtered by source disk, creation time, status and labels. Snapshots are sorted by creation time, newest first. For more information, see [the official documentation](https://yandex.cloud/docs/compute/concepts/snapshot).",
+		Read:        dataSourceYandexComputeSnapshotsRead,
+		Schema: map[string]*schema.Schema{
+			"folder_id": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["folder_id"],
+				Computed:    true,
+				Optional:    true,
+			},
+			"source_disk_id": {
+				Type:        schema.TypeString,
+				Description: "Only return snapshots of this disk.",
+				Optional:    true,
+			},
+			"created_after": {
+				Type:         schema.TypeString,
+				Description:  "Only return snapshots created at or after this time, in RFC3339 format.",
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"created_before": {
+				Type:         schema.TypeString,
+				Description:  "Only return snapshots created before this time, in RFC3339 format.",
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Description:  "Only return snapshots with this status. Possible values: `ready`, `creating`, `error`, `deleting`. Set to an empty string to return snapshots in any status. The default is `ready`.",
+				Optional:     true,
+				Default:      "ready",
+				ValidateFunc: validation.StringInSlice([]string{"", "ready", "creating", "error", "deleting"}, false),
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Description: "Only return snapshots that have all of these labels.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"snapshots": {
+				Type:        schema.TypeList,
+				Description: "The matching snapshots, newest first.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "The ID of the snapshot.",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: common.ResourceDescriptions["name"],
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: common.ResourceDescriptions["description"],
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "The status of the snapshot.",
+							Computed:    true,
+						},
+						"source_disk_id": {
+							Type:        schema.TypeString,
+							Description: resourceYandexComputeSnapshot().Schema["source_disk_id"].Description,
+							Computed:    true,
+						},
+						"disk_size": {
+							Type:        schema.TypeInt,
+							Description: resourceYandexComputeSnapshot().Schema["disk_size"].Description,
+							Computed:    true,
+						},
+						"storage_size": {
+							Type:        schema.TypeInt,
+							Description: resourceYandexComputeSnapshot().Schema["storage_size"].Description,
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Description: common.ResourceDescriptions["labels"],
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Computed:    true,
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Description: common.ResourceDescriptions["created_at"],
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Description: "IDs of the matching snapshots, newest first.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"latest_snapshot_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the newest matching snapshot, or an empty string if there are none.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type computeSnapshotsFilter struct {
+	sourceDiskID  string
+	createdAfter  time.Time
+	createdBefore time.Time
+	status        string
+	labels        map[string]string
+}
+
+func (f *computeSnapshotsFilter) match(snapshot *compute.Snapshot) bool {
+	if f.sourceDiskID != "" && snapshot.SourceDiskId != f.sourceDiskID {
+		return false
+	}
+	createdAt := snapshot.CreatedAt.AsTime()
+	if !f.createdAfter.IsZero() && createdAt.Before(f.createdAfter) {
+		return false
+	}
+	if !f.createdBefore.IsZero() && !createdAt.Before(f.createdBefore) {
+		return false
+	}
+	if f.status != "" && !strings.EqualFold(snapshot.Status.String(), f.status) {
+		return false
+	}
+	for k, v := range f.labels {
+		if snapshot.Labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// filterComputeSnapshots returns the snapshots matching the filter, newest first.
+func filterComputeSnapshots(snapshots []*compute.Snapshot, f *computeSnapshotsFilter) []*compute.Snapshot {
+	var result []*compute.Snapshot
+	for _, snapshot := range snapshots {
+		if f.match(snapshot) {
+			result = append(result, snapshot)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CreatedAt.AsTime().After(result[j].CreatedAt.AsTime())
+	})
+	return result
+}
+
+func dataSourceYandexComputeSnapshotsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	folderID, err := getFolderID(d, config)
+	if err != nil {
+		return fmt.Errorf("Error getting folder ID while reading snapshots: %s", err)
+	}
+
+	labels, err := expandLabels(d.Get("labels"))
+	if err != nil {
+		return fmt.Errorf("Error expanding labels while reading snapshots: %s", err)
+	}
+
+	filter := &computeSnapshotsFilter{
+		sourceDiskID: d.Get("source_disk_id").(string),
+		status:       d.Get("status").(string),
+		labels:       labels,
+	}
+	if v, ok := d.GetOk("created_after"); ok {
+		filter.createdAfter, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	if v, ok := d.GetOk("created_before"); ok {
+		filter.createdBefore, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	var snapshots []*compute.Snapshot
+	var token string
+	for {
+		resp, err := config.sdk.Compute().Snapshot().List(ctx, &compute.ListSnapshotsRequest{
+			FolderId:  folderID,
+			PageToken: token,
+		})
+		if err != nil {
+			return fmt.Errorf("Error while listing snapshots in folder %q: %s", folderID, err)
+		}
+		snapshots = append(snapshots, resp.Snapshots...)
+
+		token = resp.NextPageToken
+		if token == "" {
+			break
+		}
+	}
+
+	snapshots = filterComputeSnapshots(snapshots, filter)
+
+	items := make([]map[string]interface{}, 0, len(snapshots))
+	ids := make([]string, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		items = append(items, map[string]interface{}{
+			"id":             snapshot.Id,
+			"name":           snapshot.Name,
+			"description":    snapshot.Description,
+			"status":         strings.ToLower(snapshot.Status.String()),
+			"source_disk_id": snapshot.SourceDiskId,
+			"disk_size":      toGigabytes(snapshot.DiskSize),
+			"storage_size":   toGigabytes(snapshot.StorageSize),
+			"labels":         snapshot.Labels,
+			"created_at":     getTimestamp(snapshot.CreatedAt),
+		})
+		ids = append(ids, snapshot.Id)
+	}
+
+	var latest string
+	if len(ids) > 0 {
+		latest = ids[0]
+	}
+
+	d.Set("folder_id", folderID)
+	d.Set("latest_snapshot_id", latest)
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("snapshots", items); err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(fmt.Sprintf("%s:%s", folderID, strings.Join(ids, ",")))))
+	return nil
+}
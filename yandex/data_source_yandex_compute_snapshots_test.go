@@ -0,0 +1,135 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+func TestAccDataSourceComputeSnapshots_bySourceDisk(t *testing.T) {
+	t.Parallel()
+
+	diskName := acctest.RandomWithPrefix("tf-disk")
+	snapshotName := acctest.RandomWithPrefix("tf-snap")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckComputeDiskDestroy,
+			testAccCheckComputeSnapshotDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceComputeSnapshotsConfig(diskName, snapshotName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_compute_snapshots.source", "snapshots.#", "2"),
+					resource.TestCheckResourceAttr("data.yandex_compute_snapshots.source", "ids.#", "2"),
+					resource.TestCheckResourceAttrPair("data.yandex_compute_snapshots.source", "latest_snapshot_id",
+						"yandex_compute_snapshot.second", "id"),
+					resource.TestCheckResourceAttrPair("data.yandex_compute_snapshots.source", "snapshots.1.id",
+						"yandex_compute_snapshot.first", "id"),
+					resource.TestCheckResourceAttr("data.yandex_compute_snapshots.source", "snapshots.0.status", "ready"),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterComputeSnapshots(t *testing.T) {
+	at := func(s string) *timestamppb.Timestamp {
+		ts, _ := time.Parse(time.RFC3339, s)
+		return timestamppb.New(ts)
+	}
+
+	snapshots := []*compute.Snapshot{
+		{Id: "old", SourceDiskId: "disk1", Status: compute.Snapshot_READY, CreatedAt: at("2026-01-01T00:00:00Z")},
+		{Id: "new", SourceDiskId: "disk1", Status: compute.Snapshot_READY, CreatedAt: at("2026-03-01T00:00:00Z"), Labels: map[string]string{"env": "prod"}},
+		{Id: "mid", SourceDiskId: "disk1", Status: compute.Snapshot_READY, CreatedAt: at("2026-02-01T00:00:00Z")},
+		{Id: "creating", SourceDiskId: "disk1", Status: compute.Snapshot_CREATING, CreatedAt: at("2026-04-01T00:00:00Z")},
+		{Id: "other", SourceDiskId: "disk2", Status: compute.Snapshot_READY, CreatedAt: at("2026-05-01T00:00:00Z")},
+	}
+
+	ids := func(snapshots []*compute.Snapshot) []string {
+		var result []string
+		for _, s := range snapshots {
+			result = append(result, s.Id)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		filter   computeSnapshotsFilter
+		expected []string
+	}{
+		{
+			name:     "by disk and status",
+			filter:   computeSnapshotsFilter{sourceDiskID: "disk1", status: "ready"},
+			expected: []string{"new", "mid", "old"},
+		},
+		{
+			name:     "any status",
+			filter:   computeSnapshotsFilter{sourceDiskID: "disk1"},
+			expected: []string{"creating", "new", "mid", "old"},
+		},
+		{
+			name: "time range",
+			filter: computeSnapshotsFilter{
+				createdAfter:  at("2026-02-01T00:00:00Z").AsTime(),
+				createdBefore: at("2026-03-01T00:00:00Z").AsTime(),
+			},
+			expected: []string{"mid"},
+		},
+		{
+			name:     "labels",
+			filter:   computeSnapshotsFilter{labels: map[string]string{"env": "prod"}},
+			expected: []string{"new"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ids(filterComputeSnapshots(snapshots, &tt.filter)))
+		})
+	}
+}
+
+func testAccDataSourceComputeSnapshotsConfig(diskName, snapshotName string) string {
+	return fmt.Sprintf(`
+data "yandex_compute_image" "ubuntu" {
+  family = "ubuntu-1804-lts"
+}
+
+resource "yandex_compute_disk" "foobar" {
+  name     = "%[1]s"
+  image_id = "${data.yandex_compute_image.ubuntu.id}"
+  size     = 4
+}
+
+resource "yandex_compute_snapshot" "first" {
+  name           = "%[2]s-1"
+  source_disk_id = "${yandex_compute_disk.foobar.id}"
+}
+
+resource "yandex_compute_snapshot" "second" {
+  name           = "%[2]s-2"
+  source_disk_id = "${yandex_compute_disk.foobar.id}"
+
+  depends_on = [yandex_compute_snapshot.first]
+}
+
+data "yandex_compute_snapshots" "source" {
+  source_disk_id = "${yandex_compute_disk.foobar.id}"
+
+  depends_on = [yandex_compute_snapshot.second]
+}
+`, diskName, snapshotName)
+}
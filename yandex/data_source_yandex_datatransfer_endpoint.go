@@ -0,0 +1,78 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/datatransfer/v1"
+)
+
+func dataSourceYandexDatatransferEndpoint() *schema.Resource {
+	dataSource := convertResourceToDataSource(resourceYandexDatatransferEndpoint())
+
+	dataSource.Description = "Get information about a Data Transfer endpoint. For more information, see [the official documentation](https://yandex.cloud/docs/data-transfer/).\n\n~> One of `endpoint_id` or `name` should be specified.\n"
+
+	dataSource.Schema["endpoint_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "ID of the endpoint.",
+		Optional:    true,
+		Computed:    true,
+	}
+	dataSource.Schema["name"].Optional = true
+	dataSource.Schema["folder_id"].Optional = true
+	// TODO: SA1019: dataSource.Read is deprecated: Use ReadContext or ReadWithoutTimeout instead. This implementation does not support request cancellation initiated by Terraform, such as a system or practitioner sending SIGINT (Ctrl-c). This implementation also does not support warning diagnostics. (staticcheck)
+	dataSource.Read = dataSourceYandexDatatransferEndpointRead
+	return dataSource
+}
+
+func dataSourceYandexDatatransferEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	err := checkOneOf(d, "endpoint_id", "name")
+	if err != nil {
+		return err
+	}
+
+	endpointID := d.Get("endpoint_id").(string)
+	if endpointID == "" {
+		folderID, err := getFolderID(d, config)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err = resolveDatatransferEndpointID(ctx, config, folderID, d.Get("name").(string))
+		if err != nil {
+			return fmt.Errorf("failed to resolve data source Data Transfer endpoint by name: %v", err)
+		}
+	}
+
+	d.SetId(endpointID)
+	if err := resourceYandexDatatransferEndpointRead(d, meta); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("Data Transfer endpoint %q not found", endpointID)
+	}
+
+	return d.Set("endpoint_id", endpointID)
+}
+
+func resolveDatatransferEndpointID(ctx context.Context, config *Config, folderID, name string) (string, error) {
+	iterator := config.sdk.DataTransfer().Endpoint().EndpointIterator(ctx, &datatransfer.ListEndpointsRequest{
+		FolderId: folderID,
+	})
+
+	for iterator.Next() {
+		endpoint := iterator.Value()
+		if name == endpoint.Name {
+			return endpoint.Id, nil
+		}
+	}
+	if err := iterator.Error(); err != nil {
+		return "", err
+	}
+
+	return "", fmt.Errorf("endpoint %q not found in folder %q", name, folderID)
+}
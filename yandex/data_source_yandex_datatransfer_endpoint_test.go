@@ -0,0 +1,71 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceDataTransferEndpoint_byID(t *testing.T) {
+	t.Parallel()
+
+	endpointName := "ds-kafka-source-id" + randomPostfix
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataTransferDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDataTransferEndpointConfig(endpointName, true),
+				Check:  testAccDataSourceDataTransferEndpointCheck("data.yandex_datatransfer_endpoint.bar", "yandex_datatransfer_endpoint.kafka_source", endpointName),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDataTransferEndpoint_byName(t *testing.T) {
+	t.Parallel()
+
+	endpointName := "ds-kafka-source-name" + randomPostfix
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataTransferDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDataTransferEndpointConfig(endpointName, false),
+				Check:  testAccDataSourceDataTransferEndpointCheck("data.yandex_datatransfer_endpoint.bar", "yandex_datatransfer_endpoint.kafka_source", endpointName),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDataTransferEndpointCheck(datasourceName, resourceName, endpointName string) resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		testAccCheckResourceIDField(datasourceName, "endpoint_id"),
+		resource.TestCheckResourceAttrPair(datasourceName, "id", resourceName, "id"),
+		resource.TestCheckResourceAttrPair(datasourceName, "folder_id", resourceName, "folder_id"),
+		resource.TestCheckResourceAttr(datasourceName, "name", endpointName),
+		resource.TestCheckResourceAttr(datasourceName, "description", "TestAccDataSourceDataTransfer"),
+		resource.TestCheckResourceAttr(datasourceName, "settings.0.kafka_source.0.topic_name", "topic-name"),
+		resource.TestCheckResourceAttr(datasourceName, "settings.0.kafka_source.0.connection.0.on_premise.0.broker_urls.0", "localhost:1234"),
+	)
+}
+
+func testAccDataSourceDataTransferEndpointConfig(name string, useID bool) string {
+	if useID {
+		return testAccDataTransferConfigKafkaSource(name, "TestAccDataSourceDataTransfer") + `
+data "yandex_datatransfer_endpoint" "bar" {
+  endpoint_id = yandex_datatransfer_endpoint.kafka_source.id
+}
+`
+	}
+
+	return testAccDataTransferConfigKafkaSource(name, "TestAccDataSourceDataTransfer") + fmt.Sprintf(`
+data "yandex_datatransfer_endpoint" "bar" {
+  name       = "%s"
+  depends_on = [yandex_datatransfer_endpoint.kafka_source]
+}
+`, name)
+}
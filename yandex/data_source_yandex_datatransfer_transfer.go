@@ -0,0 +1,78 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/datatransfer/v1"
+)
+
+func dataSourceYandexDatatransferTransfer() *schema.Resource {
+	dataSource := convertResourceToDataSource(resourceYandexDatatransferTransfer())
+
+	dataSource.Description = "Get information about a Data Transfer transfer. For more information, see [the official documentation](https://yandex.cloud/docs/data-transfer/).\n\n~> One of `transfer_id` or `name` should be specified.\n"
+
+	dataSource.Schema["transfer_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "ID of the transfer.",
+		Optional:    true,
+		Computed:    true,
+	}
+	dataSource.Schema["name"].Optional = true
+	dataSource.Schema["folder_id"].Optional = true
+	// TODO: SA1019: dataSource.Read is deprecated: Use ReadContext or ReadWithoutTimeout instead. This implementation does not support request cancellation initiated by Terraform, such as a system or practitioner sending SIGINT (Ctrl-c). This implementation also does not support warning diagnostics. (staticcheck)
+	dataSource.Read = dataSourceYandexDatatransferTransferRead
+	return dataSource
+}
+
+func dataSourceYandexDatatransferTransferRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	err := checkOneOf(d, "transfer_id", "name")
+	if err != nil {
+		return err
+	}
+
+	transferID := d.Get("transfer_id").(string)
+	if transferID == "" {
+		folderID, err := getFolderID(d, config)
+		if err != nil {
+			return err
+		}
+
+		transferID, err = resolveDatatransferTransferID(ctx, config, folderID, d.Get("name").(string))
+		if err != nil {
+			return fmt.Errorf("failed to resolve data source Data Transfer transfer by name: %v", err)
+		}
+	}
+
+	d.SetId(transferID)
+	if err := resourceYandexDatatransferTransferRead(d, meta); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("Data Transfer transfer %q not found", transferID)
+	}
+
+	return d.Set("transfer_id", transferID)
+}
+
+func resolveDatatransferTransferID(ctx context.Context, config *Config, folderID, name string) (string, error) {
+	iterator := config.sdk.DataTransfer().Transfer().TransferIterator(ctx, &datatransfer.ListTransfersRequest{
+		FolderId: folderID,
+	})
+
+	for iterator.Next() {
+		transfer := iterator.Value()
+		if name == transfer.Name {
+			return transfer.Id, nil
+		}
+	}
+	if err := iterator.Error(); err != nil {
+		return "", err
+	}
+
+	return "", fmt.Errorf("transfer %q not found in folder %q", name, folderID)
+}
@@ -0,0 +1,72 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceDataTransferTransfer_byID(t *testing.T) {
+	t.Parallel()
+
+	params := testAccDataSourceDataTransferTransferParams("ds-id")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataTransferDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataTransferConfigMain(params) + `
+data "yandex_datatransfer_transfer" "bar" {
+  transfer_id = yandex_datatransfer_transfer.pgpg_transfer.id
+}
+`,
+				Check: testAccDataSourceDataTransferTransferCheck("data.yandex_datatransfer_transfer.bar", params),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDataTransferTransfer_byName(t *testing.T) {
+	t.Parallel()
+
+	params := testAccDataSourceDataTransferTransferParams("ds-name")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataTransferDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataTransferConfigMain(params) + `
+data "yandex_datatransfer_transfer" "bar" {
+  name = yandex_datatransfer_transfer.pgpg_transfer.name
+}
+`,
+				Check: testAccDataSourceDataTransferTransferCheck("data.yandex_datatransfer_transfer.bar", params),
+			},
+		},
+	})
+}
+
+// testAccDataSourceDataTransferTransferParams gives the resources of the test their own names,
+// so that the transfer can be found by name while the other tests are running.
+func testAccDataSourceDataTransferTransferParams(prefix string) dataTransferTerraformTemplateParams {
+	return defaultTemplateParams.
+		withSourceEndpointName(prefix + "-src-endpoint" + randomPostfix).
+		withTargetEndpointName(prefix + "-dst-endpoint" + randomPostfix).
+		withTransferName(prefix + "-transfer" + randomPostfix).
+		withActivateMode(dontActivateMode)
+}
+
+func testAccDataSourceDataTransferTransferCheck(datasourceName string, params dataTransferTerraformTemplateParams) resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		testAccCheckResourceIDField(datasourceName, "transfer_id"),
+		resource.TestCheckResourceAttrPair(datasourceName, "id", transferResourceName, "id"),
+		resource.TestCheckResourceAttrPair(datasourceName, "folder_id", transferResourceName, "folder_id"),
+		resource.TestCheckResourceAttrPair(datasourceName, "source_id", sourceEndpointResourceName, "id"),
+		resource.TestCheckResourceAttrPair(datasourceName, "target_id", targetEndpointResourceName, "id"),
+		resource.TestCheckResourceAttr(datasourceName, "name", params.TransferName),
+		resource.TestCheckResourceAttr(datasourceName, "description", params.TransferDescription),
+		resource.TestCheckResourceAttr(datasourceName, "type", params.TransferType),
+	)
+}
@@ -0,0 +1,60 @@
+package yandex
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/zonefile"
+)
+
+func dataSourceYandexDnsZoneExport() *schema.Resource {
+	return &schema.Resource{
+		Description: "Exports all records of a DNS Zone, including its SOA and NS records, in the zone file format of [RFC 1035](https://www.rfc-editor.org/rfc/rfc1035#section-5).",
+		Read:        dataSourceYandexDnsZoneExportRead,
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"dns_zone_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the DNS Zone.",
+				Required:    true,
+			},
+
+			"origin": {
+				Type:        schema.TypeString,
+				Description: "The origin of the zone file, that is the zone name.",
+				Computed:    true,
+			},
+
+			"zone_file": {
+				Type:        schema.TypeString,
+				Description: "The records of the zone in the zone file format, with names relative to `origin`.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceYandexDnsZoneExportRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sdk := getSDK(config)
+
+	id := d.Get("dns_zone_id").(string)
+	dnsZone, err := sdk.DNS().DnsZone().Get(config.Context(), &dns.GetDnsZoneRequest{
+		DnsZoneId: id,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DnsZone %q", id))
+	}
+
+	sets, err := listDnsZoneRecordSets(config.Context(), config, id)
+	if err != nil {
+		return fmt.Errorf("Error while reading records of DnsZone %q: %s", id, err)
+	}
+
+	d.SetId(dnsZone.Id)
+	d.Set("origin", dnsZone.Zone)
+	return d.Set("zone_file", zonefile.Render(dnsZone.Zone, sets))
+}
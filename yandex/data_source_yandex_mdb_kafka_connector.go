@@ -13,6 +13,7 @@ func dataSourceYandexMDBKafkaConnector() *schema.Resource {
 	dataSource.Schema["cluster_id"].Required = true
 	dataSource.Schema["name"].Computed = false
 	dataSource.Schema["name"].Required = true
+	delete(dataSource.Schema, "wait_for_running")
 	// TODO: SA1019: dataSource.Read is deprecated: Use ReadContext or ReadWithoutTimeout instead. This implementation does not support request cancellation initiated by Terraform, such as a system or practitioner sending SIGINT (Ctrl-c). This implementation also does not support warning diagnostics. (staticcheck)
 	dataSource.Read = resourceYandexMDBKafkaConnectorRead
 	return dataSource
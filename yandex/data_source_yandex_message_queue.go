@@ -3,6 +3,7 @@ package yandex
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -52,6 +53,26 @@ func dataSourceYandexMessageQueue() *schema.Resource {
 				Description: "URL of the queue.",
 				Computed:    true,
 			},
+			"fifo_queue": {
+				Type:        schema.TypeBool,
+				Description: "Is this queue [FIFO](https://yandex.cloud/docs/message-queue/concepts/queue#fifo-queues).",
+				Computed:    true,
+			},
+			"approximate_number_of_messages": {
+				Type:        schema.TypeInt,
+				Description: "Approximate number of messages available for retrieval from the queue.",
+				Computed:    true,
+			},
+			"approximate_number_of_messages_delayed": {
+				Type:        schema.TypeInt,
+				Description: "Approximate number of messages in the queue that are delayed and not available for retrieval yet.",
+				Computed:    true,
+			},
+			"approximate_number_of_messages_not_visible": {
+				Type:        schema.TypeInt,
+				Description: "Approximate number of messages that have been received but not yet deleted or moved to a Dead Letter Queue.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -96,7 +117,7 @@ func dataSourceYandexMessageQueueRead(d *schema.ResourceData, meta interface{})
 	err = resource.Retry(15*time.Second, func() *resource.RetryError {
 		attributesOutput, err = ymqClient.GetQueueAttributes(&sqs.GetQueueAttributesInput{
 			QueueUrl:       aws.String(queueURL),
-			AttributeNames: []*string{aws.String(sqs.QueueAttributeNameQueueArn)},
+			AttributeNames: []*string{aws.String(sqs.QueueAttributeNameAll)},
 		})
 
 		if err != nil {
@@ -113,8 +134,24 @@ func dataSourceYandexMessageQueueRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error getting queue attributes: %s", err)
 	}
 
-	d.Set("arn", aws.StringValue(attributesOutput.Attributes[sqs.QueueAttributeNameQueueArn]))
+	queueAttributes := aws.StringValueMap(attributesOutput.Attributes)
+	d.Set("arn", queueAttributes[sqs.QueueAttributeNameQueueArn])
 	d.Set("url", queueURL)
+	d.Set("fifo_queue", queueAttributes[sqs.QueueAttributeNameFifoQueue] == "true")
+
+	for k, attrKey := range map[string]string{
+		"approximate_number_of_messages":             sqs.QueueAttributeNameApproximateNumberOfMessages,
+		"approximate_number_of_messages_delayed":     sqs.QueueAttributeNameApproximateNumberOfMessagesDelayed,
+		"approximate_number_of_messages_not_visible": sqs.QueueAttributeNameApproximateNumberOfMessagesNotVisible,
+	} {
+		if v, ok := queueAttributes[attrKey]; ok && v != "" {
+			vInt, err := strconv.Atoi(v)
+			if err != nil {
+				return fmt.Errorf("Error parsing %s value (%s) into integer: %s", k, v, err)
+			}
+			d.Set(k, vInt)
+		}
+	}
 	d.SetId(queueURL)
 
 	return nil
@@ -20,7 +20,14 @@ func TestAccDataSourceYandexMessageQueue_basic(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceYandexMessageQueueConfig(randInt),
-				Check:  testAccDataSourceYandexMessageQueueCheck(datasourceName, resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceYandexMessageQueueCheck(datasourceName, resourceName),
+					resource.TestCheckResourceAttrPair(datasourceName, "url", resourceName, "id"),
+					resource.TestCheckResourceAttr(datasourceName, "fifo_queue", "false"),
+					resource.TestCheckResourceAttr(datasourceName, "approximate_number_of_messages", "0"),
+					resource.TestCheckResourceAttr(datasourceName, "approximate_number_of_messages_delayed", "0"),
+					resource.TestCheckResourceAttr(datasourceName, "approximate_number_of_messages_not_visible", "0"),
+				),
 			},
 		},
 	})
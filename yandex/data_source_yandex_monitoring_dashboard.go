@@ -20,6 +20,11 @@ func dataSourceYandexMonitoringDashboard() *schema.Resource {
 
 		ReadContext: dataSourceYandexMonitoringDashboardRead,
 		Schema: map[string]*schema.Schema{
+			"config_json": {
+				Type:        schema.TypeString,
+				Description: "Widgets and parametrization of the dashboard as a JSON document, which can be used as `config_json` of the `yandex_monitoring_dashboard` resource.",
+				Computed:    true,
+			},
 			"dashboard_id": {
 				Type:        schema.TypeString,
 				Description: "Dashboard ID.",
@@ -720,6 +725,12 @@ func dataSourceYandexMonitoringDashboardRead(ctxParent context.Context, d *schem
 	if err != nil {
 		return diag.FromErr(err)
 	}
+
+	configJSON, err := flattenDashboardConfigJSON(dashboard.GetWidgets(), dashboard.GetParametrization())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("config_json", configJSON)
 	return nil
 }
 
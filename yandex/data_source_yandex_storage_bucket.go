@@ -0,0 +1,42 @@
+package yandex
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceYandexStorageBucket() *schema.Resource {
+	dataSource := convertResourceToDataSource(resourceYandexStorageBucket())
+
+	dataSource.Description = "Get information about a [Yandex Cloud Storage Bucket](https://yandex.cloud/docs/storage/concepts/bucket).\n\n~> By default, for authentication, you need to use [IAM token](https://yandex.cloud/docs/iam/concepts/authorization/iam-token) with the necessary permissions. Alternatively, you can provide [static access keys](https://yandex.cloud/docs/iam/concepts/authorization/access-key) (Access and Secret).\n"
+
+	delete(dataSource.Schema, "bucket_prefix")
+	delete(dataSource.Schema, "force_destroy")
+
+	dataSource.Schema["bucket"].Description = "The name of the bucket."
+	dataSource.Schema["bucket"].Computed = false
+	dataSource.Schema["bucket"].Required = true
+	dataSource.Schema["access_key"].Computed = false
+	dataSource.Schema["access_key"].Optional = true
+	dataSource.Schema["secret_key"].Computed = false
+	dataSource.Schema["secret_key"].Optional = true
+	dataSource.ReadContext = dataSourceYandexStorageBucketRead
+	return dataSource
+}
+
+func dataSourceYandexStorageBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketName := d.Get("bucket").(string)
+	d.SetId(bucketName)
+
+	diags := resourceYandexStorageBucketRead(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	if d.Id() == "" {
+		return diag.Errorf("Storage Bucket %q not found", bucketName)
+	}
+
+	return diags
+}
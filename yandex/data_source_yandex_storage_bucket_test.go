@@ -0,0 +1,44 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceStorageBucket_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	datasourceName := "data.yandex_storage_bucket.test"
+	resourceName := "yandex_storage_bucket.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy:             testAccCheckStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceStorageBucketConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketExists(resourceName),
+					resource.TestCheckResourceAttr(datasourceName, "id", testAccBucketName(rInt)),
+					resource.TestCheckResourceAttr(datasourceName, "bucket", testAccBucketName(rInt)),
+					resource.TestCheckResourceAttr(datasourceName, "bucket_domain_name", testAccBucketDomainName(rInt)),
+					resource.TestCheckResourceAttrPair(datasourceName, "default_storage_class", resourceName, "default_storage_class"),
+					resource.TestCheckResourceAttrPair(datasourceName, "anonymous_access_flags.0.read", resourceName, "anonymous_access_flags.0.read"),
+					resource.TestCheckResourceAttrPair(datasourceName, "anonymous_access_flags.0.list", resourceName, "anonymous_access_flags.0.list"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceStorageBucketConfig(randInt int) string {
+	return testAccStorageBucketConfig(randInt) + `
+data "yandex_storage_bucket" "test" {
+  bucket     = yandex_storage_bucket.test.bucket
+  access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+  secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+}
+`
+}
@@ -0,0 +1,47 @@
+package yandex
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceYandexStorageObject() *schema.Resource {
+	dataSource := convertResourceToDataSource(resourceYandexStorageObject())
+
+	dataSource.Description = "Get information about an object in a [Yandex Cloud Storage Bucket](https://yandex.cloud/docs/storage/concepts/bucket). The object content is not read.\n"
+
+	delete(dataSource.Schema, "source")
+	delete(dataSource.Schema, "source_hash")
+	delete(dataSource.Schema, "content")
+	delete(dataSource.Schema, "content_base64")
+	delete(dataSource.Schema, "acl")
+
+	dataSource.Schema["bucket"].Computed = false
+	dataSource.Schema["bucket"].Required = true
+	dataSource.Schema["key"].Computed = false
+	dataSource.Schema["key"].Required = true
+	dataSource.Schema["access_key"].Computed = false
+	dataSource.Schema["access_key"].Optional = true
+	dataSource.Schema["secret_key"].Computed = false
+	dataSource.Schema["secret_key"].Optional = true
+	dataSource.ReadContext = dataSourceYandexStorageObjectRead
+	return dataSource
+}
+
+func dataSourceYandexStorageObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	d.SetId(key)
+
+	diags := resourceYandexStorageObjectRead(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	if d.Id() == "" {
+		return diag.Errorf("Storage Object %q not found in bucket %q", key, bucket)
+	}
+
+	return diags
+}
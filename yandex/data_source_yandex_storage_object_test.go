@@ -0,0 +1,43 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceStorageObject_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	datasourceName := "data.yandex_storage_object.test"
+	resourceName := "yandex_storage_object.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy:             testAccCheckStorageObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceStorageObjectConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "id", "test-key"),
+					resource.TestCheckResourceAttr(datasourceName, "key", "test-key"),
+					resource.TestCheckResourceAttrPair(datasourceName, "bucket", resourceName, "bucket"),
+					resource.TestCheckResourceAttr(datasourceName, "content_type", "text/plain"),
+					resource.TestCheckNoResourceAttr(datasourceName, "content"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceStorageObjectConfig(randInt int) string {
+	return testAccStorageObjectConfigContentType(randInt, "text/plain") + `
+data "yandex_storage_object" "test" {
+  bucket     = yandex_storage_object.test.bucket
+  key        = yandex_storage_object.test.key
+  access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+  secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+}
+`
+}
@@ -0,0 +1,69 @@
+package yandex
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceYandexSmartwebsecurityRuleBundle() *schema.Resource {
+	return &schema.Resource{
+		Description: "Builds a bundle of security rules that can be shared by several security profiles, see `rule_bundle` block of `yandex_sws_security_profile`. The data source doesn't call the API, it validates the rules and encodes them into `rules_json`.\n\n~> Every rule of the bundle must have a unique `name` and `priority`. The names identify the rules of the bundle in the profiles, so they must not be used by other rules of the profiles.\n",
+
+		ReadContext: dataSourceYandexSmartwebsecurityRuleBundleRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the bundle. Used only to tell the bundles apart in the configuration.",
+				Optional:    true,
+			},
+
+			"rules_json": {
+				Type:        schema.TypeString,
+				Description: "Rules of the bundle in JSON format, to be passed to the `rule_bundle` block of `yandex_sws_security_profile`.",
+				Computed:    true,
+			},
+
+			"security_rule": {
+				Type:        schema.TypeList,
+				Description: "List of security rules of the bundle, in the same format as in `yandex_sws_security_profile`.",
+				Elem:        resourceYandexSmartwebsecuritySecurityProfile().Schema["security_rule"].Elem,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func dataSourceYandexSmartwebsecurityRuleBundleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	securityRules, err := expandSecurityProfileSecurityRulesSlice(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for i, rule := range securityRules {
+		if rule.GetName() == "" || rule.GetPriority() == 0 {
+			return diag.Errorf("security_rule.%d: name and priority are required for the rules of a bundle", i)
+		}
+	}
+	if err := validateSWSSecurityRules(securityRules); err != nil {
+		return diag.FromErr(err)
+	}
+
+	rulesJSON, err := marshalSWSRuleBundle(securityRules)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("rules_json", rulesJSON); err != nil {
+		return diag.FromErr(fmt.Errorf("failed set field rules_json: %w", err))
+	}
+
+	hash := sha256.Sum256([]byte(rulesJSON))
+	d.SetId(hex.EncodeToString(hash[:]))
+	return nil
+}
@@ -0,0 +1,36 @@
+package yandex
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceYandexYDBTable() *schema.Resource {
+	dataSource := convertResourceToDataSource(resourceYandexYDBTable())
+
+	dataSource.Description = "Get information about a Yandex Database table."
+
+	dataSource.Schema["path"].Computed = false
+	dataSource.Schema["path"].Required = true
+	dataSource.Schema["connection_string"].Computed = false
+	dataSource.Schema["connection_string"].Required = true
+	dataSource.ReadContext = dataSourceYandexYDBTableRead
+	return dataSource
+}
+
+func dataSourceYandexYDBTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	path := d.Get("path").(string)
+	d.SetId(d.Get("connection_string").(string) + "?path=" + path)
+
+	diags := resourceYandexYDBTableRead(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	if d.Id() == "" {
+		return diag.Errorf("YDB table %q not found", path)
+	}
+
+	return diags
+}
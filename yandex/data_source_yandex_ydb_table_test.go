@@ -0,0 +1,58 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceYDBTable_basic(t *testing.T) {
+	ydbResourceName := fmt.Sprintf("ydb-table-ds-test-%s", acctest.RandString(5))
+	tableName := fmt.Sprintf("test-%s", acctest.RandString(5))
+	tableResourceName := fmt.Sprintf("ydb-test-table-%s", acctest.RandString(5))
+	changefeedName := fmt.Sprintf("test-changefeed-%s", acctest.RandString(5))
+	changefeedResourceName := fmt.Sprintf("ydb-test-table-changefeed-%s", acctest.RandString(5))
+	indexName := fmt.Sprintf("test-index-%s", acctest.RandString(5))
+	indexResourceName := fmt.Sprintf("ydb-test-table-index-%s", acctest.RandString(5))
+
+	existingTableResourceName := fmt.Sprintf("yandex_ydb_table.%s", tableResourceName)
+	datasourceName := "data.yandex_ydb_table.bar"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testYandexYDBDatabaseServerlessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccYDBTableConfig(
+					"",
+					ydbResourceName,
+					tableResourceName,
+					tableName,
+					indexResourceName,
+					indexName,
+					changefeedResourceName,
+					changefeedName,
+					ydbLocationId,
+				) + fmt.Sprintf(`
+	data "yandex_ydb_table" "bar" {
+		path              = yandex_ydb_table.%[1]s.path
+		connection_string = yandex_ydb_table.%[1]s.connection_string
+	}
+	`, tableResourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", existingTableResourceName, "id"),
+					resource.TestCheckResourceAttr(datasourceName, "path", tableName),
+					resource.TestCheckResourceAttr(datasourceName, "column.#", "6"),
+					resource.TestCheckResourceAttr(datasourceName, "primary_key.#", "2"),
+					resource.TestCheckResourceAttr(datasourceName, "primary_key.0", "a"),
+					resource.TestCheckResourceAttr(datasourceName, "primary_key.1", "b"),
+					resource.TestCheckResourceAttr(datasourceName, "ttl.0.column_name", "d"),
+					resource.TestCheckResourceAttr(datasourceName, "read_replicas_settings", "PER_AZ:1"),
+					resource.TestCheckResourceAttr(datasourceName, "key_bloom_filter", "true"),
+				),
+			},
+		},
+	})
+}
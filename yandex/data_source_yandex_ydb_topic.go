@@ -0,0 +1,36 @@
+package yandex
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceYandexYDBTopic() *schema.Resource {
+	dataSource := convertResourceToDataSource(resourceYandexYDBTopic())
+
+	dataSource.Description = "Get information about a YDB Topic. For more information, see [the official documentation](https://yandex.cloud/docs/ydb/concepts/#ydb)."
+
+	dataSource.Schema["database_endpoint"].Computed = false
+	dataSource.Schema["database_endpoint"].Required = true
+	dataSource.Schema["name"].Computed = false
+	dataSource.Schema["name"].Required = true
+	dataSource.ReadContext = dataSourceYandexYDBTopicRead
+	return dataSource
+}
+
+func dataSourceYandexYDBTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+	d.SetId(d.Get("database_endpoint").(string) + "?path=" + name)
+
+	diags := resourceYandexYDBTopicRead(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	if d.Id() == "" {
+		return diag.Errorf("YDB topic %q not found", name)
+	}
+
+	return diags
+}
@@ -0,0 +1,48 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceYDBTopic_basic(t *testing.T) {
+	ydbResourceName := fmt.Sprintf("ydb-topic-ds-test-%s", acctest.RandString(5))
+	topicName := fmt.Sprintf("test-%s", acctest.RandString(5))
+	topicResourceName := fmt.Sprintf("ydb-test-topic-%s", acctest.RandString(5))
+
+	existingTopicResourceName := fmt.Sprintf("yandex_ydb_topic.%s", topicResourceName)
+	datasourceName := "data.yandex_ydb_topic.bar"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testYandexYDBDatabaseServerlessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccYDBTopicConfig(
+					"",
+					ydbResourceName,
+					topicResourceName,
+					topicName,
+					ydbLocationId,
+				) + fmt.Sprintf(`
+	data "yandex_ydb_topic" "bar" {
+		name              = yandex_ydb_topic.%[1]s.name
+		database_endpoint = yandex_ydb_topic.%[1]s.database_endpoint
+	}
+	`, topicResourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", existingTopicResourceName, "id"),
+					resource.TestCheckResourceAttr(datasourceName, "name", topicName),
+					resource.TestCheckResourceAttr(datasourceName, "partitions_count", "4"),
+					resource.TestCheckResourceAttr(datasourceName, "retention_period_hours", "12"),
+					resource.TestCheckResourceAttr(datasourceName, "metering_mode", "reserved_capacity"),
+					resource.TestCheckResourceAttr(datasourceName, "consumer.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "consumer.0.name", "consumer"),
+				),
+			},
+		},
+	})
+}
@@ -21,7 +21,11 @@ type Client struct {
 	s3 *s3.S3
 }
 
-func NewClient(ctx context.Context, accessKey, secretKey, iamToken, url string) (*Client, error) {
+// IAMTokenFunc returns the IAM token to authenticate a request with. It is called for every request,
+// so it should return a cached token.
+type IAMTokenFunc func(ctx context.Context) (string, error)
+
+func NewClient(ctx context.Context, accessKey, secretKey string, iamToken IAMTokenFunc, url string) (*Client, error) {
 	if url == "" {
 		return nil, fmt.Errorf("storage endpoint url is not specified")
 	}
@@ -37,7 +41,7 @@ func NewClient(ctx context.Context, accessKey, secretKey, iamToken, url string)
 	switch {
 	case accessKey != "" && secretKey != "":
 		config.Credentials = credentials.NewStaticCredentials(accessKey, secretKey, "")
-	case iamToken != "":
+	case iamToken != nil:
 		config.Credentials = credentials.AnonymousCredentials
 		config.HTTPClient = &http.Client{
 			Transport: newTransport(iamToken),
@@ -64,10 +68,10 @@ func (c *Client) S3() *s3.S3 {
 
 type iamTransport struct {
 	Transport http.RoundTripper
-	IAMToken  string
+	IAMToken  IAMTokenFunc
 }
 
-func newTransport(iamToken string) http.RoundTripper {
+func newTransport(iamToken IAMTokenFunc) http.RoundTripper {
 	return &iamTransport{
 		Transport: http.DefaultTransport,
 		IAMToken:  iamToken,
@@ -75,6 +79,12 @@ func newTransport(iamToken string) http.RoundTripper {
 }
 
 func (t *iamTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	req.Header.Set(iamTokenHeader, t.IAMToken)
+	token, err := t.IAMToken(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	// RoundTrip must not modify the request
+	req = req.Clone(req.Context())
+	req.Header.Set(iamTokenHeader, token)
 	return t.Transport.RoundTrip(req)
 }
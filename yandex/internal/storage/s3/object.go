@@ -14,7 +14,9 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/mitchellh/go-homedir"
 )
 
@@ -300,3 +302,128 @@ func (c *Client) DeleteObject(ctx context.Context, bucket, key string) error {
 
 	return nil
 }
+
+// UploadObject streams body to the bucket with a multipart upload, so that large files are not read into memory.
+func (c *Client) UploadObject(ctx context.Context, bucket, key string, body io.Reader) error {
+	uploader := s3manager.NewUploaderWithClient(c.s3)
+	_, err := uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   body,
+	})
+	if err != nil {
+		return fmt.Errorf("error uploading object %q to bucket %q: %w", key, bucket, err)
+	}
+	return nil
+}
+
+// GetObjectSize returns the size of the object in bytes.
+func (c *Client) GetObjectSize(ctx context.Context, bucket, key string) (int64, error) {
+	resp, err := c.s3.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error reading object (%s): %w", key, err)
+	}
+	return aws.Int64Value(resp.ContentLength), nil
+}
+
+// GetObjectContent returns the content of the object, or nil if the object is larger than maxSize bytes.
+func (c *Client) GetObjectContent(ctx context.Context, bucket, key string, maxSize int64) ([]byte, error) {
+	size, err := c.GetObjectSize(ctx, bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	if size > maxSize {
+		return nil, nil
+	}
+
+	resp, err := c.s3.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading object (%s): %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("error reading object (%s): %w", key, err)
+	}
+	if int64(len(content)) > maxSize {
+		return nil, nil
+	}
+	return content, nil
+}
+
+// GetObjectACL returns the predefined ACL that grants the same permissions as the ACL of the object,
+// or an empty string if there is no such predefined ACL.
+func (c *Client) GetObjectACL(ctx context.Context, bucket, key string) (string, error) {
+	resp, err := RetryLongTermOperations[*s3.GetObjectAclOutput](
+		ctx,
+		func() (*s3.GetObjectAclOutput, error) {
+			return c.s3.GetObjectAclWithContext(ctx, &s3.GetObjectAclInput{
+				Bucket: aws.String(bucket),
+				Key:    aws.String(key),
+			})
+		},
+	)
+	if err != nil {
+		return "", fmt.Errorf("error getting object ACL (%s): %w", key, err)
+	}
+	log.Printf("[DEBUG] Reading storage object ACL: %s", resp)
+
+	return predefinedObjectACL(aws.StringValue(resp.Owner.ID), resp.Grants), nil
+}
+
+const (
+	allUsersGroupURI           = "http://acs.amazonaws.com/groups/global/AllUsers"
+	authenticatedUsersGroupURI = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+)
+
+func predefinedObjectACL(ownerID string, grants []*s3.Grant) string {
+	permissions := map[string]bool{}
+	for _, grant := range grants {
+		grantee := aws.StringValue(grant.Grantee.URI)
+		if grantee == "" {
+			grantee = aws.StringValue(grant.Grantee.ID)
+		}
+		permission := aws.StringValue(grant.Permission)
+		if grantee == ownerID && permission == s3.PermissionFullControl {
+			continue
+		}
+		permissions[grantee+":"+permission] = true
+	}
+
+	switch {
+	case len(permissions) == 0:
+		return s3.ObjectCannedACLPrivate
+	case len(permissions) == 1 && permissions[allUsersGroupURI+":"+s3.PermissionRead]:
+		return s3.ObjectCannedACLPublicRead
+	case len(permissions) == 2 && permissions[allUsersGroupURI+":"+s3.PermissionRead] && permissions[allUsersGroupURI+":"+s3.PermissionWrite]:
+		return s3.ObjectCannedACLPublicReadWrite
+	case len(permissions) == 1 && permissions[authenticatedUsersGroupURI+":"+s3.PermissionRead]:
+		return s3.ObjectCannedACLAuthenticatedRead
+	}
+	return ""
+}
+
+// PresignGetObject returns a URL to download the object without credentials, valid for expire.
+// It requires the client to use static access keys, since requests authorized by an IAM token can't be presigned.
+func (c *Client) PresignGetObject(bucket, key string, expire time.Duration) (string, error) {
+	if c.s3.Config.Credentials == credentials.AnonymousCredentials {
+		return "", fmt.Errorf("presigned URLs require static access keys of the storage client")
+	}
+
+	req, _ := c.s3.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	url, err := req.Presign(expire)
+	if err != nil {
+		return "", fmt.Errorf("error presigning object %q in bucket %q: %w", key, bucket, err)
+	}
+	return url, nil
+}
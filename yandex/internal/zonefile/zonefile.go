@@ -0,0 +1,436 @@
+// Package zonefile parses and renders DNS zones in the RFC 1035 master file format.
+//
+// Only the subset used by zone exports is supported: $ORIGIN and $TTL directives,
+// comments, quoted strings and multi-line records in parentheses. Records are grouped
+// into record sets by name and type, in the form used by the Cloud DNS API.
+package zonefile
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// RecordSet is a set of records of the same name and type. Name is absolute (ends with a dot).
+type RecordSet struct {
+	Name string
+	Type string
+	TTL  int64
+	Data []string
+}
+
+// Key identifies the record set within a zone.
+func (rs RecordSet) Key() string {
+	return rs.Name + " " + rs.Type
+}
+
+// Equal reports whether record sets have the same name, type, TTL and data, regardless of the data order.
+func (rs RecordSet) Equal(other RecordSet) bool {
+	if rs.Key() != other.Key() || rs.TTL != other.TTL || len(rs.Data) != len(other.Data) {
+		return false
+	}
+	a, b := sortedCopy(rs.Data), sortedCopy(other.Data)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Normalize returns the record set with a canonical form of its name and data, sorted, so that record sets
+// read from the API can be compared with parsed ones. Names are case-insensitive, the API returns them lowercase.
+func Normalize(rs RecordSet) RecordSet {
+	data := make([]string, len(rs.Data))
+	for i, d := range rs.Data {
+		data[i] = normalizeData(rs.Type, d)
+	}
+	sort.Strings(data)
+	return RecordSet{
+		Name: strings.ToLower(rs.Name),
+		Type: strings.ToUpper(rs.Type),
+		TTL:  rs.TTL,
+		Data: data,
+	}
+}
+
+// Sort sorts record sets the way they are rendered: SOA and NS records first, then by name and type.
+func Sort(sets []RecordSet) {
+	sort.SliceStable(sets, func(i, j int) bool {
+		pi, pj := typeOrder(sets[i].Type), typeOrder(sets[j].Type)
+		if pi != pj {
+			return pi < pj
+		}
+		if sets[i].Name != sets[j].Name {
+			return sets[i].Name < sets[j].Name
+		}
+		return sets[i].Type < sets[j].Type
+	})
+}
+
+// Parse parses the zone file text into record sets. Relative names are resolved against origin,
+// unless it is changed by the $ORIGIN directive.
+func Parse(text, origin string) ([]RecordSet, error) {
+	entries, err := lex(text)
+	if err != nil {
+		return nil, err
+	}
+
+	origin = absoluteName(origin, ".")
+	var (
+		defaultTTL int64 = -1
+		lastTTL    int64 = -1
+		owner      string
+		sets       []RecordSet
+		index      = make(map[string]int)
+	)
+
+	for _, e := range entries {
+		first := e.tokens[0]
+		if !e.blankOwner && !first.quoted && strings.HasPrefix(first.value, "$") {
+			if len(e.tokens) < 2 {
+				return nil, fmt.Errorf("line %d: %s requires an argument", e.line, first.value)
+			}
+			switch strings.ToUpper(first.value) {
+			case "$ORIGIN":
+				origin = absoluteName(e.tokens[1].value, origin)
+			case "$TTL":
+				ttl, err := parseTTL(e.tokens[1].value)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", e.line, err)
+				}
+				defaultTTL = ttl
+			default:
+				return nil, fmt.Errorf("line %d: unsupported directive %s", e.line, first.value)
+			}
+			continue
+		}
+
+		tokens := e.tokens
+		if !e.blankOwner {
+			owner = strings.ToLower(absoluteName(tokens[0].value, origin))
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, fmt.Errorf("line %d: record without owner name", e.line)
+		}
+
+		var ttl int64 = -1
+		// TTL and class may go in any order before the type
+		for i := 0; i < 2 && len(tokens) > 0; i++ {
+			value := tokens[0].value
+			if v, err := parseTTL(value); err == nil && ttl < 0 {
+				ttl = v
+			} else if isClass(value) {
+				if !strings.EqualFold(value, "IN") {
+					return nil, fmt.Errorf("line %d: unsupported class %s", e.line, value)
+				}
+			} else {
+				break
+			}
+			tokens = tokens[1:]
+		}
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("line %d: record type and data are required", e.line)
+		}
+
+		recordType := strings.ToUpper(tokens[0].value)
+		data := renderData(recordType, tokens[1:], origin)
+
+		switch {
+		case ttl >= 0:
+		case defaultTTL >= 0:
+			ttl = defaultTTL
+		case lastTTL >= 0:
+			ttl = lastTTL
+		default:
+			return nil, fmt.Errorf("line %d: TTL is not set and there is no $TTL directive", e.line)
+		}
+		lastTTL = ttl
+
+		rs := RecordSet{Name: owner, Type: recordType, TTL: ttl}
+		if i, ok := index[rs.Key()]; ok {
+			if sets[i].TTL != ttl {
+				return nil, fmt.Errorf("line %d: records of %s have different TTLs %d and %d", e.line, rs.Key(), sets[i].TTL, ttl)
+			}
+			sets[i].Data = append(sets[i].Data, data)
+			continue
+		}
+		rs.Data = []string{data}
+		index[rs.Key()] = len(sets)
+		sets = append(sets, rs)
+	}
+
+	for i := range sets {
+		sets[i] = Normalize(sets[i])
+	}
+	Sort(sets)
+	return sets, nil
+}
+
+// Render renders record sets in the zone file format, with names relative to origin.
+func Render(origin string, sets []RecordSet) string {
+	origin = absoluteName(origin, ".")
+	sorted := make([]RecordSet, len(sets))
+	for i, rs := range sets {
+		sorted[i] = Normalize(rs)
+	}
+	Sort(sorted)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s\n", origin)
+	for _, rs := range sorted {
+		for _, d := range rs.Data {
+			fmt.Fprintf(&b, "%s\t%d\tIN\t%s\t%s\n", relativeName(rs.Name, origin), rs.TTL, rs.Type, d)
+		}
+	}
+	return b.String()
+}
+
+// IsManagedByZone reports whether the record set is created and maintained by the zone itself (SOA and apex NS).
+func IsManagedByZone(origin string, rs RecordSet) bool {
+	origin = absoluteName(origin, ".")
+	return rs.Type == "SOA" || (rs.Type == "NS" && strings.EqualFold(rs.Name, origin))
+}
+
+type token struct {
+	value  string
+	quoted bool
+}
+
+type entry struct {
+	tokens     []token
+	blankOwner bool
+	line       int
+}
+
+func lex(text string) ([]entry, error) {
+	var (
+		entries     []entry
+		current     entry
+		buf         strings.Builder
+		inToken     bool
+		inQuote     bool
+		quotedToken bool
+		depth       int
+		line        = 1
+		lineStart   = true
+	)
+
+	endToken := func() {
+		if inToken {
+			current.tokens = append(current.tokens, token{value: buf.String(), quoted: quotedToken})
+			buf.Reset()
+			inToken, quotedToken = false, false
+		}
+	}
+	endEntry := func() {
+		endToken()
+		if len(current.tokens) > 0 {
+			entries = append(entries, current)
+		}
+		current = entry{}
+	}
+
+	runes := []rune(text)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		if lineStart && depth == 0 && len(current.tokens) == 0 && !inToken {
+			current.line = line
+			current.blankOwner = r == ' ' || r == '\t'
+		}
+		lineStart = false
+
+		switch {
+		case inQuote:
+			buf.WriteRune(r)
+			switch r {
+			case '\\':
+				if i+1 < len(runes) {
+					i++
+					buf.WriteRune(runes[i])
+				}
+			case '"':
+				inQuote = false
+			case '\n':
+				return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+			}
+		case r == '"':
+			if inToken && !quotedToken {
+				endToken()
+			}
+			inToken, inQuote, quotedToken = true, true, true
+			buf.WriteRune(r)
+		case r == '\\':
+			inToken = true
+			buf.WriteRune(r)
+			if i+1 < len(runes) {
+				i++
+				buf.WriteRune(runes[i])
+			}
+		case r == ';':
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+		case r == '(':
+			endToken()
+			depth++
+		case r == ')':
+			endToken()
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+			}
+			depth--
+		case r == '\n':
+			if depth == 0 {
+				endEntry()
+			} else {
+				endToken()
+			}
+			line++
+			lineStart = true
+		case unicode.IsSpace(r):
+			endToken()
+		default:
+			if quotedToken {
+				endToken()
+			}
+			inToken = true
+			buf.WriteRune(r)
+		}
+	}
+	if inQuote {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+	}
+	endEntry()
+	return entries, nil
+}
+
+// nameFields lists positions of domain names within the record data, which are resolved against the origin.
+var nameFields = map[string][]int{
+	"CNAME": {0},
+	"DNAME": {0},
+	"NS":    {0},
+	"PTR":   {0},
+	"ANAME": {0},
+	"MX":    {1},
+	"SRV":   {3},
+	"SOA":   {0, 1},
+}
+
+func renderData(recordType string, tokens []token, origin string) string {
+	values := make([]string, len(tokens))
+	for i, t := range tokens {
+		values[i] = t.value
+	}
+	for _, i := range nameFields[recordType] {
+		if i < len(tokens) && !tokens[i].quoted {
+			values[i] = absoluteName(values[i], origin)
+		}
+	}
+	return strings.Join(values, " ")
+}
+
+func normalizeData(recordType, data string) string {
+	data = strings.TrimSpace(data)
+	if strings.EqualFold(recordType, "TXT") && !strings.HasPrefix(data, `"`) {
+		return quote(data)
+	}
+	return data
+}
+
+func quote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`
+}
+
+func absoluteName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	case origin == ".":
+		return name + "."
+	default:
+		return name + "." + origin
+	}
+}
+
+func relativeName(name, origin string) string {
+	if strings.EqualFold(name, origin) {
+		return "@"
+	}
+	if suffix := "." + origin; len(name) > len(suffix) && strings.EqualFold(name[len(name)-len(suffix):], suffix) {
+		return name[:len(name)-len(suffix)]
+	}
+	return name
+}
+
+// parseTTL parses TTL either in seconds, or in BIND format with units, like 1h30m.
+func parseTTL(s string) (int64, error) {
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return v, nil
+	}
+
+	var total, current int64
+	hasDigits := false
+	for _, r := range strings.ToLower(s) {
+		if r >= '0' && r <= '9' {
+			current = current*10 + int64(r-'0')
+			hasDigits = true
+			continue
+		}
+		unit, ok := ttlUnits[r]
+		if !ok || !hasDigits {
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		total += current * unit
+		current, hasDigits = 0, false
+	}
+	if hasDigits {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+	return total, nil
+}
+
+var ttlUnits = map[rune]int64{
+	's': 1,
+	'm': 60,
+	'h': 60 * 60,
+	'd': 24 * 60 * 60,
+	'w': 7 * 24 * 60 * 60,
+}
+
+func isClass(s string) bool {
+	switch strings.ToUpper(s) {
+	case "IN", "CH", "HS", "CS":
+		return true
+	}
+	return false
+}
+
+func typeOrder(recordType string) int {
+	switch recordType {
+	case "SOA":
+		return 0
+	case "NS":
+		return 1
+	}
+	return 2
+}
+
+func sortedCopy(values []string) []string {
+	c := append([]string(nil), values...)
+	sort.Strings(c)
+	return c
+}
@@ -0,0 +1,155 @@
+package zonefile
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testZone = `$ORIGIN example.com.
+$TTL 1h
+; zone apex
+@	IN	SOA	ns1.yandexcloud.net. mx.cloud.yandex.net. (
+		1	; serial
+		10800	; refresh
+		900	; retry
+		604800	; expire
+		86400 )	; minimum
+	IN	NS	ns1.yandexcloud.net.
+	IN	NS	ns2.yandexcloud.net.
+@	300	IN	A	192.0.2.1
+www	IN	300	CNAME	@
+mail	IN	A	192.0.2.2
+@	IN	MX	10 mail
+@	IN	MX	20 mail.example.org.
+@	IN	TXT	"v=spf1 include:_spf.example.org -all"
+txt	IN	TXT	"quoted \"string\"; not a comment" "second part"
+_sip._tcp	1d	IN	SRV	10 60 5060 sip
+sub.example.com.	IN	NS	ns.sub
+`
+
+func TestParse(t *testing.T) {
+	sets, err := Parse(testZone, "ignored.org.")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []RecordSet{
+		{Name: "example.com.", Type: "SOA", TTL: 3600, Data: []string{"ns1.yandexcloud.net. mx.cloud.yandex.net. 1 10800 900 604800 86400"}},
+		{Name: "example.com.", Type: "NS", TTL: 3600, Data: []string{"ns1.yandexcloud.net.", "ns2.yandexcloud.net."}},
+		{Name: "sub.example.com.", Type: "NS", TTL: 3600, Data: []string{"ns.sub.example.com."}},
+		{Name: "_sip._tcp.example.com.", Type: "SRV", TTL: 86400, Data: []string{"10 60 5060 sip.example.com."}},
+		{Name: "example.com.", Type: "A", TTL: 300, Data: []string{"192.0.2.1"}},
+		{Name: "example.com.", Type: "MX", TTL: 3600, Data: []string{"10 mail.example.com.", "20 mail.example.org."}},
+		{Name: "example.com.", Type: "TXT", TTL: 3600, Data: []string{`"v=spf1 include:_spf.example.org -all"`}},
+		{Name: "mail.example.com.", Type: "A", TTL: 3600, Data: []string{"192.0.2.2"}},
+		{Name: "txt.example.com.", Type: "TXT", TTL: 3600, Data: []string{`"quoted \"string\"; not a comment" "second part"`}},
+		{Name: "www.example.com.", Type: "CNAME", TTL: 300, Data: []string{"example.com."}},
+	}
+	if !reflect.DeepEqual(expected, sets) {
+		t.Errorf("unexpected record sets:\nexpected: %v\nactual:   %v", expected, sets)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := map[string]string{
+		"no TTL":             "www IN A 192.0.2.1\n",
+		"unknown class":      "$TTL 60\nwww CH A 192.0.2.1\n",
+		"no owner":           "$TTL 60\n\tIN A 192.0.2.1\n",
+		"different TTLs":     "www 60 IN A 192.0.2.1\nwww 120 IN A 192.0.2.2\n",
+		"unbalanced":         "$TTL 60\n@ IN SOA ns. mx. ( 1 2 3 4 5\n",
+		"unterminated quote": "$TTL 60\n@ IN TXT \"text\n",
+		"unsupported":        "$INCLUDE other.zone\n",
+		"invalid TTL":        "$TTL 1x\n",
+		"no data":            "$TTL 60\nwww IN A\n",
+	}
+	for name, text := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Parse(text, "example.com."); err == nil {
+				t.Errorf("expected error for %q", text)
+			}
+		})
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	cases := map[string]int64{
+		"0":     0,
+		"3600":  3600,
+		"1h":    3600,
+		"1h30m": 5400,
+		"1W":    604800,
+		"2d12h": 216000,
+	}
+	for s, expected := range cases {
+		v, err := parseTTL(s)
+		if err != nil || v != expected {
+			t.Errorf("parseTTL(%q) = %d, %v; expected %d", s, v, err, expected)
+		}
+	}
+}
+
+func TestRenderRoundTrip(t *testing.T) {
+	sets, err := Parse(testZone, "example.com.")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	text := Render("example.com.", sets)
+	if !strings.HasPrefix(text, "$ORIGIN example.com.\n@\t3600\tIN\tSOA\t") {
+		t.Errorf("unexpected rendering:\n%s", text)
+	}
+	if !strings.Contains(text, "www\t300\tIN\tCNAME\texample.com.\n") {
+		t.Errorf("expected relative names in rendering:\n%s", text)
+	}
+
+	parsed, err := Parse(text, "other.org.")
+	if err != nil {
+		t.Fatalf("failed to parse rendered zone: %s\n%s", err, text)
+	}
+	if !reflect.DeepEqual(sets, parsed) {
+		t.Errorf("round trip changed record sets:\nexpected: %v\nactual:   %v", sets, parsed)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	rs := Normalize(RecordSet{Name: "example.com.", Type: "txt", TTL: 60, Data: []string{`say "hi"`, `"quoted"`}})
+	expected := RecordSet{Name: "example.com.", Type: "TXT", TTL: 60, Data: []string{`"quoted"`, `"say \"hi\""`}}
+	if !reflect.DeepEqual(expected, rs) {
+		t.Errorf("unexpected normalized record set: %v", rs)
+	}
+
+	if !rs.Equal(RecordSet{Name: "example.com.", Type: "TXT", TTL: 60, Data: []string{`"say \"hi\""`, `"quoted"`}}) {
+		t.Errorf("expected record sets to be equal regardless of data order")
+	}
+}
+
+func TestParseMixedCaseNames(t *testing.T) {
+	sets, err := Parse("$TTL 60\nWWW IN A 192.0.2.1\nwww IN A 192.0.2.2\n$ORIGIN Sub.Example.COM.\n@ IN A 192.0.2.3\n", "Example.COM")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []RecordSet{
+		{Name: "sub.example.com.", Type: "A", TTL: 60, Data: []string{"192.0.2.3"}},
+		{Name: "www.example.com.", Type: "A", TTL: 60, Data: []string{"192.0.2.1", "192.0.2.2"}},
+	}
+	if !reflect.DeepEqual(expected, sets) {
+		t.Errorf("unexpected record sets:\nexpected: %v\nactual:   %v", expected, sets)
+	}
+	if !sets[1].Equal(Normalize(RecordSet{Name: "WWW.example.com.", Type: "A", TTL: 60, Data: []string{"192.0.2.2", "192.0.2.1"}})) {
+		t.Errorf("expected names to be compared regardless of case")
+	}
+}
+
+func TestIsManagedByZone(t *testing.T) {
+	if !IsManagedByZone("example.com.", RecordSet{Name: "example.com.", Type: "SOA"}) {
+		t.Errorf("expected SOA to be managed by zone")
+	}
+	if !IsManagedByZone("example.com", RecordSet{Name: "example.com.", Type: "NS"}) {
+		t.Errorf("expected apex NS to be managed by zone")
+	}
+	if IsManagedByZone("example.com.", RecordSet{Name: "sub.example.com.", Type: "NS"}) {
+		t.Errorf("expected delegation NS not to be managed by zone")
+	}
+}
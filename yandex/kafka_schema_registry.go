@@ -0,0 +1,357 @@
+package yandex
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+)
+
+const (
+	kafkaSchemaRegistryContentType = "application/vnd.schemaregistry.v1+json"
+
+	kafkaSchemaTypeAvro     = "AVRO"
+	kafkaSchemaTypeProtobuf = "PROTOBUF"
+	kafkaSchemaTypeJSON     = "JSON"
+)
+
+var kafkaSchemaRegistryCompatibilityLevels = []string{
+	"BACKWARD",
+	"BACKWARD_TRANSITIVE",
+	"FORWARD",
+	"FORWARD_TRANSITIVE",
+	"FULL",
+	"FULL_TRANSITIVE",
+	"NONE",
+}
+
+// kafkaSchemaRegistryConnectionSchema returns the attributes to connect to the schema registry of a Kafka cluster,
+// shared by the schema registry resources.
+func kafkaSchemaRegistryConnectionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cluster_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the Kafka cluster. The schema registry must be enabled in the cluster with `config.schema_registry`.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"username": {
+			Type:        schema.TypeString,
+			Description: "The name of the Kafka user to connect to the schema registry with. The user must have the `ACCESS_ROLE_SCHEMA_WRITER` role.",
+			Required:    true,
+		},
+		"password": {
+			Type:        schema.TypeString,
+			Description: "The password of the Kafka user.",
+			Required:    true,
+			Sensitive:   true,
+		},
+		"endpoint": {
+			Type:        schema.TypeString,
+			Description: "URL of the schema registry. By default, `https://<FQDN of the first Kafka host of the cluster>:443` is used.",
+			Optional:    true,
+		},
+		"ca_certificate": {
+			Type:        schema.TypeString,
+			Description: "PEM-encoded certificate of the CA to verify the schema registry with. The hosts of managed Kafka clusters use certificates issued by the [Yandex Cloud CA](https://storage.yandexcloud.net/cloud-certs/CA.pem), which is usually missing in the system trust store.",
+			Optional:    true,
+		},
+	}
+}
+
+type kafkaSchemaRegistryClient struct {
+	endpoint   string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+// kafkaSchemaRegistryConnection is implemented by both *schema.ResourceData and *schema.ResourceDiff,
+// so that the client can also be created while planning.
+type kafkaSchemaRegistryConnection interface {
+	Get(key string) interface{}
+}
+
+func newKafkaSchemaRegistryClient(ctx context.Context, config *Config, d kafkaSchemaRegistryConnection) (*kafkaSchemaRegistryClient, error) {
+	endpoint := d.Get("endpoint").(string)
+	if endpoint == "" {
+		var err error
+		endpoint, err = kafkaSchemaRegistryEndpoint(ctx, config, d.Get("cluster_id").(string))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	httpClient := http.DefaultClient
+	if caCertificate := d.Get("ca_certificate").(string); caCertificate != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caCertificate)) {
+			return nil, fmt.Errorf("ca_certificate contains no PEM-encoded certificates")
+		}
+		// the default transport is cloned to keep its proxy settings and timeouts
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+		httpClient = &http.Client{Transport: transport}
+	}
+
+	return &kafkaSchemaRegistryClient{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		username:   d.Get("username").(string),
+		password:   d.Get("password").(string),
+		httpClient: httpClient,
+	}, nil
+}
+
+func kafkaSchemaRegistryEndpoint(ctx context.Context, config *Config, clusterID string) (string, error) {
+	hosts, err := listKafkaHosts(ctx, config, clusterID)
+	if err != nil {
+		return "", err
+	}
+	for _, host := range hosts {
+		if host.GetRole() == kafka.Host_KAFKA {
+			return fmt.Sprintf("https://%s:443", host.GetName()), nil
+		}
+	}
+	return "", fmt.Errorf("Kafka cluster %q has no Kafka hosts to connect to the schema registry", clusterID)
+}
+
+type kafkaSchemaRegistryError struct {
+	StatusCode int
+	ErrorCode  int    `json:"error_code"`
+	Message    string `json:"message"`
+}
+
+func (e *kafkaSchemaRegistryError) Error() string {
+	return fmt.Sprintf("schema registry responded with status %d (error code %d): %s", e.StatusCode, e.ErrorCode, e.Message)
+}
+
+func isKafkaSchemaRegistryNotFound(err error) bool {
+	var registryErr *kafkaSchemaRegistryError
+	return errors.As(err, &registryErr) && registryErr.StatusCode == http.StatusNotFound
+}
+
+func (c *kafkaSchemaRegistryClient) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", kafkaSchemaRegistryContentType)
+	if in != nil {
+		req.Header.Set("Content-Type", kafkaSchemaRegistryContentType)
+	}
+	req.SetBasicAuth(c.username, c.password)
+
+	log.Printf("[DEBUG] Sending schema registry request: %s %s", method, path)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		registryErr := &kafkaSchemaRegistryError{}
+		if json.Unmarshal(respBody, registryErr) != nil || registryErr.Message == "" {
+			registryErr.Message = string(respBody)
+		}
+		registryErr.StatusCode = resp.StatusCode
+		return registryErr
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to parse schema registry response: %w", err)
+	}
+	return nil
+}
+
+func (c *kafkaSchemaRegistryClient) GetSubjectCompatibility(ctx context.Context, subject string) (string, error) {
+	var resp struct {
+		CompatibilityLevel string `json:"compatibilityLevel"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/config/"+url.PathEscape(subject), nil, &resp); err != nil {
+		return "", err
+	}
+	return resp.CompatibilityLevel, nil
+}
+
+func (c *kafkaSchemaRegistryClient) SetSubjectCompatibility(ctx context.Context, subject, compatibility string) error {
+	req := map[string]string{"compatibility": compatibility}
+	return c.do(ctx, http.MethodPut, "/config/"+url.PathEscape(subject), req, nil)
+}
+
+func (c *kafkaSchemaRegistryClient) DeleteSubjectCompatibility(ctx context.Context, subject string) error {
+	return c.do(ctx, http.MethodDelete, "/config/"+url.PathEscape(subject), nil, nil)
+}
+
+type kafkaSchemaRegistryReference struct {
+	Name    string `json:"name"`
+	Subject string `json:"subject"`
+	Version int    `json:"version"`
+}
+
+type kafkaSchemaRegistrySchema struct {
+	Subject string `json:"subject,omitempty"`
+	Version int    `json:"version,omitempty"`
+	ID      int    `json:"id,omitempty"`
+	// SchemaType is empty for Avro schemas
+	SchemaType string                         `json:"schemaType,omitempty"`
+	Schema     string                         `json:"schema"`
+	References []kafkaSchemaRegistryReference `json:"references,omitempty"`
+}
+
+// RegisterSchema registers the schema under the subject and returns the ID of the schema.
+// If the subject already has the same schema, the existing version is kept.
+func (c *kafkaSchemaRegistryClient) RegisterSchema(ctx context.Context, subject string, s *kafkaSchemaRegistrySchema) (int, error) {
+	var resp struct {
+		ID int `json:"id"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/subjects/"+url.PathEscape(subject)+"/versions", s, &resp); err != nil {
+		return 0, err
+	}
+	return resp.ID, nil
+}
+
+func (c *kafkaSchemaRegistryClient) GetLatestSchema(ctx context.Context, subject string) (*kafkaSchemaRegistrySchema, error) {
+	s := &kafkaSchemaRegistrySchema{}
+	if err := c.do(ctx, http.MethodGet, "/subjects/"+url.PathEscape(subject)+"/versions/latest", nil, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// CheckSchemaCompatibility checks the schema against the latest version of the subject with its compatibility level
+// and returns the reasons of incompatibility, if any.
+func (c *kafkaSchemaRegistryClient) CheckSchemaCompatibility(ctx context.Context, subject string, s *kafkaSchemaRegistrySchema) (bool, []string, error) {
+	var resp struct {
+		IsCompatible bool     `json:"is_compatible"`
+		Messages     []string `json:"messages"`
+	}
+	path := "/compatibility/subjects/" + url.PathEscape(subject) + "/versions/latest?verbose=true"
+	if err := c.do(ctx, http.MethodPost, path, s, &resp); err != nil {
+		return false, nil, err
+	}
+	return resp.IsCompatible, resp.Messages, nil
+}
+
+// DeleteSubject deletes all versions of the subject permanently, since soft-deleted versions still count
+// when the subject is registered again. The registry only deletes soft-deleted versions permanently,
+// so they are soft-deleted first, unless they already are.
+func (c *kafkaSchemaRegistryClient) DeleteSubject(ctx context.Context, subject string) error {
+	path := "/subjects/" + url.PathEscape(subject)
+	if err := c.do(ctx, http.MethodDelete, path, nil, nil); err != nil && !isKafkaSchemaRegistryNotFound(err) {
+		return err
+	}
+	return c.do(ctx, http.MethodDelete, path+"?permanent=true", nil, nil)
+}
+
+func expandKafkaSchemaType(schemaType string) string {
+	if schemaType == kafkaSchemaTypeAvro {
+		return ""
+	}
+	return schemaType
+}
+
+func flattenKafkaSchemaType(schemaType string) string {
+	if schemaType == "" {
+		return kafkaSchemaTypeAvro
+	}
+	return schemaType
+}
+
+// kafkaSchemasEqual compares Avro and JSON schemas as JSON documents and Protobuf schemas
+// without comments and formatting, as the registry may reformat them.
+func kafkaSchemasEqual(schemaType, old, new string) bool {
+	if schemaType == kafkaSchemaTypeProtobuf {
+		return normalizeKafkaProtobufSchema(old) == normalizeKafkaProtobufSchema(new)
+	}
+	oldJSON, err := structure.NormalizeJsonString(old)
+	if err != nil {
+		return false
+	}
+	newJSON, err := structure.NormalizeJsonString(new)
+	if err != nil {
+		return false
+	}
+	return oldJSON == newJSON
+}
+
+// normalizeKafkaProtobufSchema returns the tokens of the schema separated by single spaces, with comments removed.
+// String literals are kept as is.
+func normalizeKafkaProtobufSchema(schema string) string {
+	var tokens []string
+	var token strings.Builder
+	flush := func() {
+		if token.Len() > 0 {
+			tokens = append(tokens, token.String())
+			token.Reset()
+		}
+	}
+
+	for i := 0; i < len(schema); i++ {
+		c := schema[i]
+		switch {
+		case c == '"' || c == '\'':
+			flush()
+			end := i + 1
+			for end < len(schema) && schema[end] != c {
+				if schema[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(schema) {
+				end = len(schema) - 1
+			}
+			tokens = append(tokens, schema[i:end+1])
+			i = end
+		case strings.HasPrefix(schema[i:], "//"):
+			flush()
+			for i < len(schema) && schema[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(schema[i:], "/*"):
+			flush()
+			end := strings.Index(schema[i+2:], "*/")
+			if end < 0 {
+				i = len(schema)
+			} else {
+				i += end + 3
+			}
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			flush()
+		case strings.IndexByte("{}[]()<>;,=", c) >= 0:
+			flush()
+			tokens = append(tokens, string(c))
+		default:
+			token.WriteByte(c)
+		}
+	}
+	flush()
+	return strings.Join(tokens, " ")
+}
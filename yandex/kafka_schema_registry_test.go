@@ -0,0 +1,314 @@
+package yandex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeKafkaSchemaRegistry is an in-memory stand-in for the Karapace REST API, implementing the endpoints used by the provider.
+type fakeKafkaSchemaRegistry struct {
+	mu            sync.Mutex
+	compatibility map[string]string
+	versions      map[string][]*kafkaSchemaRegistrySchema
+	// softDeleted subjects keep their versions until they are deleted permanently
+	softDeleted map[string]bool
+	nextID      int
+	// incompatible makes the compatibility check fail with the message
+	incompatible string
+}
+
+func newFakeKafkaSchemaRegistry(t *testing.T) (*fakeKafkaSchemaRegistry, *httptest.Server) {
+	registry := &fakeKafkaSchemaRegistry{
+		compatibility: map[string]string{},
+		versions:      map[string][]*kafkaSchemaRegistrySchema{},
+		softDeleted:   map[string]bool{},
+		nextID:        1,
+	}
+	server := httptest.NewServer(registry)
+	t.Cleanup(server.Close)
+	return registry, server
+}
+
+func (r *fakeKafkaSchemaRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if username, password, ok := req.BasicAuth(); !ok || username != "schema-writer" || password != "secret" {
+		r.respond(w, http.StatusUnauthorized, map[string]interface{}{"error_code": 40101, "message": "Unauthorized"})
+		return
+	}
+
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	switch {
+	case len(parts) == 2 && parts[0] == "config":
+		r.serveConfig(w, req, parts[1])
+	case len(parts) == 2 && parts[0] == "subjects" && req.Method == http.MethodDelete:
+		if _, ok := r.versions[parts[1]]; !ok {
+			r.respondNotFound(w)
+			return
+		}
+		if req.URL.Query().Get("permanent") != "true" {
+			if r.softDeleted[parts[1]] {
+				r.respond(w, http.StatusNotFound, map[string]interface{}{"error_code": 40404, "message": "Subject was soft deleted."})
+				return
+			}
+			r.softDeleted[parts[1]] = true
+			r.respond(w, http.StatusOK, []int{1})
+			return
+		}
+		if !r.softDeleted[parts[1]] {
+			r.respond(w, http.StatusNotFound, map[string]interface{}{"error_code": 40405, "message": "Subject was not deleted first before being permanently deleted."})
+			return
+		}
+		delete(r.versions, parts[1])
+		delete(r.softDeleted, parts[1])
+		r.respond(w, http.StatusOK, []int{1})
+	case len(parts) == 3 && parts[0] == "subjects" && parts[2] == "versions" && req.Method == http.MethodPost:
+		s := &kafkaSchemaRegistrySchema{}
+		if err := json.NewDecoder(req.Body).Decode(s); err != nil {
+			r.respond(w, http.StatusUnprocessableEntity, map[string]interface{}{"error_code": 42201, "message": err.Error()})
+			return
+		}
+		if r.softDeleted[parts[1]] {
+			// the versions of a soft-deleted subject are still numbered after the deleted ones
+			delete(r.softDeleted, parts[1])
+		}
+		s.Subject = parts[1]
+		s.ID = r.nextID
+		s.Version = len(r.versions[parts[1]]) + 1
+		r.nextID++
+		r.versions[parts[1]] = append(r.versions[parts[1]], s)
+		r.respond(w, http.StatusOK, map[string]int{"id": s.ID})
+	case len(parts) == 4 && parts[0] == "subjects" && parts[3] == "latest" && req.Method == http.MethodGet:
+		versions := r.versions[parts[1]]
+		if len(versions) == 0 || r.softDeleted[parts[1]] {
+			r.respondNotFound(w)
+			return
+		}
+		r.respond(w, http.StatusOK, versions[len(versions)-1])
+	case len(parts) == 5 && parts[0] == "compatibility" && req.Method == http.MethodPost:
+		if len(r.versions[parts[2]]) == 0 {
+			r.respondNotFound(w)
+			return
+		}
+		if r.incompatible != "" {
+			r.respond(w, http.StatusOK, map[string]interface{}{"is_compatible": false, "messages": []string{r.incompatible}})
+			return
+		}
+		r.respond(w, http.StatusOK, map[string]interface{}{"is_compatible": true})
+	default:
+		r.respond(w, http.StatusNotFound, map[string]interface{}{"error_code": 404, "message": "HTTP 404 Not Found"})
+	}
+}
+
+func (r *fakeKafkaSchemaRegistry) serveConfig(w http.ResponseWriter, req *http.Request, subject string) {
+	switch req.Method {
+	case http.MethodGet:
+		compatibility, ok := r.compatibility[subject]
+		if !ok {
+			r.respondNotFound(w)
+			return
+		}
+		r.respond(w, http.StatusOK, map[string]string{"compatibilityLevel": compatibility})
+	case http.MethodPut:
+		var body map[string]string
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			r.respond(w, http.StatusUnprocessableEntity, map[string]interface{}{"error_code": 42203, "message": err.Error()})
+			return
+		}
+		r.compatibility[subject] = body["compatibility"]
+		r.respond(w, http.StatusOK, body)
+	case http.MethodDelete:
+		if _, ok := r.compatibility[subject]; !ok {
+			r.respondNotFound(w)
+			return
+		}
+		delete(r.compatibility, subject)
+		r.respond(w, http.StatusOK, map[string]string{})
+	}
+}
+
+func (r *fakeKafkaSchemaRegistry) respondNotFound(w http.ResponseWriter) {
+	r.respond(w, http.StatusNotFound, map[string]interface{}{"error_code": 40401, "message": "Subject not found."})
+}
+
+func (r *fakeKafkaSchemaRegistry) respond(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", kafkaSchemaRegistryContentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func newTestKafkaSchemaRegistryClient(t *testing.T, raw map[string]interface{}) *kafkaSchemaRegistryClient {
+	d := schema.TestResourceDataRaw(t, resourceYandexMDBKafkaSchema().Schema, raw)
+	client, err := newKafkaSchemaRegistryClient(context.Background(), nil, d)
+	require.NoError(t, err)
+	return client
+}
+
+func TestKafkaSchemaRegistrySubjectCompatibility(t *testing.T) {
+	_, server := newFakeKafkaSchemaRegistry(t)
+	client := newTestKafkaSchemaRegistryClient(t, map[string]interface{}{
+		"endpoint": server.URL + "/",
+		"username": "schema-writer",
+		"password": "secret",
+	})
+	ctx := context.Background()
+
+	_, err := client.GetSubjectCompatibility(ctx, "orders-value")
+	require.True(t, isKafkaSchemaRegistryNotFound(err), "unexpected error: %v", err)
+
+	require.NoError(t, client.SetSubjectCompatibility(ctx, "orders-value", "FULL_TRANSITIVE"))
+	compatibility, err := client.GetSubjectCompatibility(ctx, "orders-value")
+	require.NoError(t, err)
+	assert.Equal(t, "FULL_TRANSITIVE", compatibility)
+
+	require.NoError(t, client.DeleteSubjectCompatibility(ctx, "orders-value"))
+	_, err = client.GetSubjectCompatibility(ctx, "orders-value")
+	require.True(t, isKafkaSchemaRegistryNotFound(err), "unexpected error: %v", err)
+}
+
+func TestKafkaSchemaRegistrySchema(t *testing.T) {
+	registry, server := newFakeKafkaSchemaRegistry(t)
+	raw := map[string]interface{}{
+		"endpoint": server.URL,
+		"username": "schema-writer",
+		"password": "secret",
+		"subject":  "orders-value",
+		"schema":   `{"type": "record", "name": "Order", "fields": [{"name": "id", "type": "string"}]}`,
+		"reference": []interface{}{
+			map[string]interface{}{"name": "Customer", "subject": "customers-value", "version": 2},
+		},
+	}
+	client := newTestKafkaSchemaRegistryClient(t, raw)
+	s := expandKafkaSchema(schema.TestResourceDataRaw(t, resourceYandexMDBKafkaSchema().Schema, raw))
+	ctx := context.Background()
+
+	_, _, err := client.CheckSchemaCompatibility(ctx, "orders-value", s)
+	require.True(t, isKafkaSchemaRegistryNotFound(err), "unexpected error: %v", err)
+
+	id, err := client.RegisterSchema(ctx, "orders-value", s)
+	require.NoError(t, err)
+	assert.Equal(t, 1, id)
+
+	latest, err := client.GetLatestSchema(ctx, "orders-value")
+	require.NoError(t, err)
+	assert.Equal(t, 1, latest.Version)
+	assert.Equal(t, kafkaSchemaTypeAvro, flattenKafkaSchemaType(latest.SchemaType))
+	assert.Equal(t, []map[string]interface{}{
+		{"name": "Customer", "subject": "customers-value", "version": 2},
+	}, flattenKafkaSchemaReferences(latest.References))
+
+	compatible, _, err := client.CheckSchemaCompatibility(ctx, "orders-value", s)
+	require.NoError(t, err)
+	assert.True(t, compatible)
+
+	registry.mu.Lock()
+	registry.incompatible = "Incompatibility{type:READER_FIELD_MISSING_DEFAULT_VALUE, location:/fields/1}"
+	registry.mu.Unlock()
+	compatible, messages, err := client.CheckSchemaCompatibility(ctx, "orders-value", s)
+	require.NoError(t, err)
+	assert.False(t, compatible)
+	assert.Equal(t, []string{registry.incompatible}, messages)
+
+	require.NoError(t, client.DeleteSubject(ctx, "orders-value"))
+	_, err = client.GetLatestSchema(ctx, "orders-value")
+	require.True(t, isKafkaSchemaRegistryNotFound(err), "unexpected error: %v", err)
+
+	// the subject is deleted permanently, so it's registered again from the first version
+	_, err = client.RegisterSchema(ctx, "orders-value", s)
+	require.NoError(t, err)
+	latest, err = client.GetLatestSchema(ctx, "orders-value")
+	require.NoError(t, err)
+	assert.Equal(t, 1, latest.Version)
+
+	// a subject soft-deleted outside of Terraform is deleted permanently too
+	registry.mu.Lock()
+	registry.softDeleted["orders-value"] = true
+	registry.mu.Unlock()
+	require.NoError(t, client.DeleteSubject(ctx, "orders-value"))
+	err = client.DeleteSubject(ctx, "orders-value")
+	require.True(t, isKafkaSchemaRegistryNotFound(err), "unexpected error: %v", err)
+}
+
+func TestKafkaSchemaRegistryUnauthorized(t *testing.T) {
+	_, server := newFakeKafkaSchemaRegistry(t)
+	client := newTestKafkaSchemaRegistryClient(t, map[string]interface{}{
+		"endpoint": server.URL,
+		"username": "schema-writer",
+		"password": "wrong",
+	})
+
+	_, err := client.GetLatestSchema(context.Background(), "orders-value")
+	require.EqualError(t, err, "schema registry responded with status 401 (error code 40101): Unauthorized")
+}
+
+func TestKafkaSchemaRegistryInvalidCACertificate(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceYandexMDBKafkaSchema().Schema, map[string]interface{}{
+		"endpoint":       "https://localhost:443",
+		"ca_certificate": "not a certificate",
+	})
+	_, err := newKafkaSchemaRegistryClient(context.Background(), nil, d)
+	require.EqualError(t, err, "ca_certificate contains no PEM-encoded certificates")
+}
+
+func TestKafkaSchemasEqual(t *testing.T) {
+	assert.True(t, kafkaSchemasEqual(kafkaSchemaTypeAvro,
+		`{"type": "record", "name": "Order", "fields": []}`,
+		`{"fields":[],"name":"Order","type":"record"}`))
+	assert.False(t, kafkaSchemasEqual(kafkaSchemaTypeJSON, `{"type": "object"}`, `{"type": "string"}`))
+	assert.True(t, kafkaSchemasEqual(kafkaSchemaTypeProtobuf, "syntax = \"proto3\";\n", "syntax = \"proto3\";"))
+	assert.False(t, kafkaSchemasEqual(kafkaSchemaTypeProtobuf, "message A {}", "message B {}"))
+	assert.True(t, kafkaSchemasEqual(kafkaSchemaTypeProtobuf,
+		"syntax = \"proto3\";\n// orders\nmessage Order {\n  string id = 1; /* key */\n}\n",
+		"syntax = \"proto3\";\n\nmessage Order {\n\tstring id=1;\n}"))
+	assert.False(t, kafkaSchemasEqual(kafkaSchemaTypeProtobuf,
+		"option go_package = \"example.com/a b\";",
+		"option go_package = \"example.com/a  b\";"))
+}
+
+func TestKafkaSchemaReadKeepsConfiguredSchema(t *testing.T) {
+	registry, server := newFakeKafkaSchemaRegistry(t)
+	d := schema.TestResourceDataRaw(t, resourceYandexMDBKafkaSchema().Schema, map[string]interface{}{
+		"endpoint":    server.URL,
+		"username":    "schema-writer",
+		"password":    "secret",
+		"subject":     "orders-value",
+		"schema_type": kafkaSchemaTypeProtobuf,
+		"schema":      "syntax = \"proto3\";\nmessage Order { string id = 1; }\n",
+	})
+	d.SetId("orders-value")
+	config := &Config{contextWithClientTraceID: context.Background()}
+
+	require.NoError(t, registerKafkaSchema(context.Background(), config, d))
+	registry.mu.Lock()
+	// the registry returns the schema in its own format
+	registry.versions["orders-value"][0].Schema = "syntax = \"proto3\";\n\nmessage Order {\n  string id = 1;\n}\n"
+	registry.mu.Unlock()
+
+	require.NoError(t, resourceYandexMDBKafkaSchemaRead(d, config))
+	assert.Equal(t, "syntax = \"proto3\";\nmessage Order { string id = 1; }\n", d.Get("schema"))
+	assert.Equal(t, 1, d.Get("schema_id"))
+
+	// the schema registered outside of Terraform is read from the registry
+	registry.mu.Lock()
+	registry.versions["orders-value"] = append(registry.versions["orders-value"], &kafkaSchemaRegistrySchema{
+		SchemaType: "PROTOBUF",
+		Schema:     "syntax = \"proto3\";\nmessage Order {}\n",
+		ID:         2,
+		Version:    2,
+	})
+	registry.mu.Unlock()
+
+	require.NoError(t, resourceYandexMDBKafkaSchemaRead(d, config))
+	assert.Equal(t, "syntax = \"proto3\";\nmessage Order {}\n", d.Get("schema"))
+	assert.Equal(t, 2, d.Get("schema_id"))
+}
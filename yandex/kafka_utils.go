@@ -2,11 +2,14 @@ package yandex
 
 import (
 	"context"
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
 )
 
-//go:generate ../scripts/mockgen.sh KafkaTopicModifier
+//go:generate ../scripts/mockgen.sh KafkaTopicModifier,KafkaUserPermissionModifier
 
 type KafkaTopicModifier interface {
 	CreateKafkaTopic(ctx context.Context, d *schema.ResourceData, topicSpec *kafka.TopicSpec) error
@@ -33,3 +36,97 @@ func (tm *KafkaTopicManager) DeleteKafkaTopic(ctx context.Context, d *schema.Res
 func (tm *KafkaTopicManager) UpdateKafkaTopic(ctx context.Context, d *schema.ResourceData, topicSpec *kafka.TopicSpec, paths []string) error {
 	return updateKafkaTopic(ctx, tm.Config, d, topicSpec, paths)
 }
+
+type KafkaUserPermissionModifier interface {
+	GetKafkaUserPermissions(ctx context.Context, clusterID, userName string) ([]*kafka.Permission, error)
+	SetKafkaUserPermissions(ctx context.Context, clusterID, userName string, permissions []*kafka.Permission) error
+}
+
+type KafkaUserPermissionManager struct {
+	Config *Config
+}
+
+func NewKafkaUserPermissionManager(config *Config) *KafkaUserPermissionManager {
+	return &KafkaUserPermissionManager{Config: config}
+}
+
+func (pm *KafkaUserPermissionManager) GetKafkaUserPermissions(ctx context.Context, clusterID, userName string) ([]*kafka.Permission, error) {
+	user, err := pm.Config.sdk.MDB().Kafka().User().Get(ctx, &kafka.GetUserRequest{
+		ClusterId: clusterID,
+		UserName:  userName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user.GetPermissions(), nil
+}
+
+func (pm *KafkaUserPermissionManager) SetKafkaUserPermissions(ctx context.Context, clusterID, userName string, permissions []*kafka.Permission) error {
+	return updateKafkaUser(ctx, pm.Config, &kafka.UpdateUserRequest{
+		ClusterId:   clusterID,
+		UserName:    userName,
+		Permissions: permissions,
+		UpdateMask:  &field_mask.FieldMask{Paths: []string{"permissions"}},
+	})
+}
+
+// modifyKafkaUserPermissions replaces the permissions of the user with the result of modify, holding the lock of the user,
+// so that the permission resources of the same user don't overwrite the changes of each other.
+func modifyKafkaUserPermissions(ctx context.Context, pm KafkaUserPermissionModifier, clusterID, userName string, modify func([]*kafka.Permission) ([]*kafka.Permission, error)) error {
+	mutexKey := fmt.Sprintf("kafka-user-permissions-%s-%s", clusterID, userName)
+	mutexKV.Lock(mutexKey)
+	defer mutexKV.Unlock(mutexKey)
+
+	permissions, err := pm.GetKafkaUserPermissions(ctx, clusterID, userName)
+	if err != nil {
+		return fmt.Errorf("error while getting permissions of user %q in Kafka Cluster %q: %w", userName, clusterID, err)
+	}
+
+	permissions, err = modify(permissions)
+	if err != nil {
+		return err
+	}
+	sortPermissions(permissions)
+	return pm.SetKafkaUserPermissions(ctx, clusterID, userName, permissions)
+}
+
+// findKafkaUserPermission returns the index of the permission to the topic with the role, or -1 if the user doesn't have it.
+func findKafkaUserPermission(permissions []*kafka.Permission, topicName string, role kafka.Permission_AccessRole) int {
+	for i, p := range permissions {
+		if p.GetTopicName() == topicName && p.GetRole() == role {
+			return i
+		}
+	}
+	return -1
+}
+
+func grantKafkaUserPermission(ctx context.Context, pm KafkaUserPermissionModifier, clusterID, userName string, permission *kafka.Permission) error {
+	return modifyKafkaUserPermissions(ctx, pm, clusterID, userName, func(permissions []*kafka.Permission) ([]*kafka.Permission, error) {
+		if findKafkaUserPermission(permissions, permission.TopicName, permission.Role) >= 0 {
+			return nil, fmt.Errorf("user %q in Kafka Cluster %q already has role %s for topic %q, import it to manage it with Terraform",
+				userName, clusterID, permission.Role, permission.TopicName)
+		}
+		return append(permissions, permission), nil
+	})
+}
+
+func updateKafkaUserPermission(ctx context.Context, pm KafkaUserPermissionModifier, clusterID, userName string, permission *kafka.Permission) error {
+	return modifyKafkaUserPermissions(ctx, pm, clusterID, userName, func(permissions []*kafka.Permission) ([]*kafka.Permission, error) {
+		i := findKafkaUserPermission(permissions, permission.TopicName, permission.Role)
+		if i < 0 {
+			return append(permissions, permission), nil
+		}
+		permissions[i] = permission
+		return permissions, nil
+	})
+}
+
+func revokeKafkaUserPermission(ctx context.Context, pm KafkaUserPermissionModifier, clusterID, userName, topicName string, role kafka.Permission_AccessRole) error {
+	return modifyKafkaUserPermissions(ctx, pm, clusterID, userName, func(permissions []*kafka.Permission) ([]*kafka.Permission, error) {
+		i := findKafkaUserPermission(permissions, topicName, role)
+		if i < 0 {
+			return permissions, nil
+		}
+		return append(permissions[:i], permissions[i+1:]...), nil
+	})
+}
@@ -127,6 +127,13 @@ func DestroyOutputToLockboxVersion(ctx context.Context, d *schema.ResourceData,
 func moveSensitiveAttrsToNewLockboxVersion(ctx context.Context, d *schema.ResourceData, config *Config, sensitiveAttrs []string, secretID string) error {
 	var entries []*lockbox.PayloadEntryChange
 	for _, sensitiveAttr := range sensitiveAttrs {
+		// imported resources don't have sensitive values, unless they were encrypted with pgp_key on creation
+		if d.Get(sensitiveAttr).(string) == "" && d.Get("pgp_key").(string) == "" {
+			// keep the prior state, so that output_to_lockbox is not recorded as applied
+			d.Partial(true)
+			return fmt.Errorf("the value of sensitive attribute '%s' is unknown, probably because the resource was imported, so it can't be stored in Lockbox; recreate the resource to use output_to_lockbox", sensitiveAttr)
+		}
+
 		entry := new(lockbox.PayloadEntryChange)
 		_, entryKey := getEntryKeyForSensitiveAttr(d, sensitiveAttr) // get new value, since output_to_lockbox was added
 		log.Printf("[DEBUG] - sensitive attribute '%s' will be stored in entry key '%s'", sensitiveAttr, entryKey)
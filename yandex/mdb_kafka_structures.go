@@ -1215,6 +1215,58 @@ func flattenKafkaUI(c *kafka.ConfigSpec) []map[string]interface{} {
 	return []map[string]interface{}{out}
 }
 
+// kafkaConnectorType binds a connector-specific config block of yandex_mdb_kafka_connector to the API,
+// so supporting a new type of connector only takes adding an entry to kafkaConnectorTypes.
+type kafkaConnectorType struct {
+	configKey    string
+	description  string
+	schema       func() *schema.Resource
+	expand       func(d *schema.ResourceData, spec *kafka.ConnectorSpec)
+	expandUpdate func(d *schema.ResourceData, spec *kafka.UpdateConnectorSpec)
+	// flatten returns nil if the connector is of another type.
+	flatten        func(conn *kafka.Connector, d *schema.ResourceData) ([]map[string]interface{}, error)
+	addUpdatePaths func(commonKeyPrefix string, commonValPrefix string)
+}
+
+var kafkaConnectorTypes = []*kafkaConnectorType{
+	{
+		configKey:   "connector_config_mirrormaker",
+		description: "Settings for MirrorMaker2 connector.",
+		schema:      resourceYandexMDBKafkaConnectorMirrormakerConfig,
+		expand: func(d *schema.ResourceData, spec *kafka.ConnectorSpec) {
+			spec.SetConnectorConfigMirrormaker(buildKafkaMirrorMakerSpec(d))
+		},
+		expandUpdate: func(d *schema.ResourceData, spec *kafka.UpdateConnectorSpec) {
+			spec.SetConnectorConfigMirrormaker(buildKafkaMirrorMakerSpec(d))
+		},
+		flatten: func(conn *kafka.Connector, d *schema.ResourceData) ([]map[string]interface{}, error) {
+			if conn.GetConnectorConfigMirrormaker() == nil {
+				return nil, nil
+			}
+			return flattenKafkaConnectorMirrormaker(conn.GetConnectorConfigMirrormaker())
+		},
+		addUpdatePaths: addMirrormakerUpdatePathsToFieldsMap,
+	},
+	{
+		configKey:   "connector_config_s3_sink",
+		description: "Settings for S3 Sink connector.",
+		schema:      resourceYandexMDBKafkaConnectorS3SinkConfig,
+		expand: func(d *schema.ResourceData, spec *kafka.ConnectorSpec) {
+			spec.SetConnectorConfigS3Sink(buildKafkaS3SinkConnectorSpec(d))
+		},
+		expandUpdate: func(d *schema.ResourceData, spec *kafka.UpdateConnectorSpec) {
+			spec.SetConnectorConfigS3Sink(buildKafkaS3SinkConnectorSpecUpdate(d))
+		},
+		flatten: func(conn *kafka.Connector, d *schema.ResourceData) ([]map[string]interface{}, error) {
+			if conn.GetConnectorConfigS3Sink() == nil {
+				return nil, nil
+			}
+			return flattenKafkaConnectorS3Sink(conn.GetConnectorConfigS3Sink(), d)
+		},
+		addUpdatePaths: addS3SinkUpdatePathsToFieldsMap,
+	},
+}
+
 func flattenKafkaConnectorMirrormaker(mm *kafka.ConnectorConfigMirrorMaker) ([]map[string]interface{}, error) {
 	config := map[string]interface{}{
 		"topics":             mm.Topics,
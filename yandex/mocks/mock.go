@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/yandex-cloud/terraform-provider-yandex/yandex (interfaces: KafkaTopicModifier)
+// Source: github.com/yandex-cloud/terraform-provider-yandex/yandex (interfaces: KafkaTopicModifier,KafkaUserPermissionModifier)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -77,3 +77,55 @@ func (mr *MockKafkaTopicModifierMockRecorder) UpdateKafkaTopic(arg0, arg1, arg2,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKafkaTopic", reflect.TypeOf((*MockKafkaTopicModifier)(nil).UpdateKafkaTopic), arg0, arg1, arg2, arg3)
 }
+
+// MockKafkaUserPermissionModifier is a mock of KafkaUserPermissionModifier interface.
+type MockKafkaUserPermissionModifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockKafkaUserPermissionModifierMockRecorder
+}
+
+// MockKafkaUserPermissionModifierMockRecorder is the mock recorder for MockKafkaUserPermissionModifier.
+type MockKafkaUserPermissionModifierMockRecorder struct {
+	mock *MockKafkaUserPermissionModifier
+}
+
+// NewMockKafkaUserPermissionModifier creates a new mock instance.
+func NewMockKafkaUserPermissionModifier(ctrl *gomock.Controller) *MockKafkaUserPermissionModifier {
+	mock := &MockKafkaUserPermissionModifier{ctrl: ctrl}
+	mock.recorder = &MockKafkaUserPermissionModifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKafkaUserPermissionModifier) EXPECT() *MockKafkaUserPermissionModifierMockRecorder {
+	return m.recorder
+}
+
+// GetKafkaUserPermissions mocks base method.
+func (m *MockKafkaUserPermissionModifier) GetKafkaUserPermissions(arg0 context.Context, arg1, arg2 string) ([]*kafka.Permission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKafkaUserPermissions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*kafka.Permission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKafkaUserPermissions indicates an expected call of GetKafkaUserPermissions.
+func (mr *MockKafkaUserPermissionModifierMockRecorder) GetKafkaUserPermissions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKafkaUserPermissions", reflect.TypeOf((*MockKafkaUserPermissionModifier)(nil).GetKafkaUserPermissions), arg0, arg1, arg2)
+}
+
+// SetKafkaUserPermissions mocks base method.
+func (m *MockKafkaUserPermissionModifier) SetKafkaUserPermissions(arg0 context.Context, arg1, arg2 string, arg3 []*kafka.Permission) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetKafkaUserPermissions", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetKafkaUserPermissions indicates an expected call of SetKafkaUserPermissions.
+func (mr *MockKafkaUserPermissionModifierMockRecorder) SetKafkaUserPermissions(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKafkaUserPermissions", reflect.TypeOf((*MockKafkaUserPermissionModifier)(nil).SetKafkaUserPermissions), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,81 @@
+package yandex
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/monitoring/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// expandDashboardConfigJSON parses a dashboard exported from the console or by the data source.
+// Only widgets and parametrization are taken from the document, other dashboard fields are ignored.
+func expandDashboardConfigJSON(configJSON string) ([]*monitoring.Widget, *monitoring.Parametrization, error) {
+	dashboard := new(monitoring.Dashboard)
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal([]byte(configJSON), dashboard); err != nil {
+		return nil, nil, fmt.Errorf("config_json is not a valid dashboard: %s", err)
+	}
+	return dashboard.GetWidgets(), dashboard.GetParametrization(), nil
+}
+
+// flattenDashboardConfigJSON returns the widgets and parametrization of the dashboard as an indented JSON document
+// with sorted keys, so that the output is stable.
+func flattenDashboardConfigJSON(widgets []*monitoring.Widget, parametrization *monitoring.Parametrization) (string, error) {
+	dashboard := &monitoring.Dashboard{
+		Widgets:         widgets,
+		Parametrization: parametrization,
+	}
+
+	raw, err := protojson.Marshal(dashboard)
+	if err != nil {
+		return "", err
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return "", err
+	}
+	out, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// normalizeDashboardConfigJSON converts the document to the form returned by flattenDashboardConfigJSON,
+// without the chart IDs that are assigned by the server.
+func normalizeDashboardConfigJSON(configJSON string) (string, error) {
+	widgets, parametrization, err := expandDashboardConfigJSON(configJSON)
+	if err != nil {
+		return "", err
+	}
+	for _, widget := range widgets {
+		if chart := widget.GetChart(); chart != nil {
+			chart.Id = ""
+		}
+	}
+	return flattenDashboardConfigJSON(widgets, parametrization)
+}
+
+func validateDashboardConfigJSON(v interface{}, k string) ([]string, []error) {
+	if _, _, err := expandDashboardConfigJSON(v.(string)); err != nil {
+		return nil, []error{err}
+	}
+	return nil, nil
+}
+
+func suppressDashboardConfigJSONDiff(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return old == new
+	}
+	oldNormalized, err := normalizeDashboardConfigJSON(old)
+	if err != nil {
+		return false
+	}
+	newNormalized, err := normalizeDashboardConfigJSON(new)
+	if err != nil {
+		return false
+	}
+	return oldNormalized == newNormalized
+}
@@ -0,0 +1,69 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMonitoringDashboardConfigJSON = `{
+  "id": "fbe1234567890",
+  "folderId": "b1g1234567890",
+  "name": "exported",
+  "parametrization": {"selectors": "a=b"},
+  "widgets": [
+    {
+      "position": {"x": 0, "y": 0, "w": 6, "h": 4},
+      "chart": {
+        "id": "chart1",
+        "title": "CPU",
+        "queries": {"targets": [{"query": "{service=compute}", "textMode": true}]}
+      }
+    },
+    {
+      "position": {"x": 6, "y": 0, "w": 6, "h": 1},
+      "text": {"text": "notes"}
+    }
+  ]
+}`
+
+func TestExpandDashboardConfigJSON(t *testing.T) {
+	widgets, parametrization, err := expandDashboardConfigJSON(testMonitoringDashboardConfigJSON)
+	require.NoError(t, err)
+	require.Len(t, widgets, 2)
+	assert.Equal(t, "chart1", widgets[0].GetChart().GetId())
+	assert.Equal(t, "{service=compute}", widgets[0].GetChart().GetQueries().GetTargets()[0].GetQuery())
+	assert.Equal(t, "notes", widgets[1].GetText().GetText())
+	assert.Equal(t, "a=b", parametrization.GetSelectors())
+
+	_, _, err = expandDashboardConfigJSON(`{"widgets": {}}`)
+	assert.Error(t, err)
+}
+
+func TestFlattenDashboardConfigJSONRoundTrip(t *testing.T) {
+	widgets, parametrization, err := expandDashboardConfigJSON(testMonitoringDashboardConfigJSON)
+	require.NoError(t, err)
+
+	configJSON, err := flattenDashboardConfigJSON(widgets, parametrization)
+	require.NoError(t, err)
+	assert.NotContains(t, configJSON, "folderId")
+
+	again, _, err := expandDashboardConfigJSON(configJSON)
+	require.NoError(t, err)
+	assert.Len(t, again, 2)
+}
+
+func TestSuppressDashboardConfigJSONDiff(t *testing.T) {
+	reordered := `{"widgets": [
+  {"chart": {"queries": {"targets": [{"textMode": true, "query": "{service=compute}"}]}, "title": "CPU"},
+   "position": {"h": 4, "w": 6}},
+  {"text": {"text": "notes"}, "position": {"x": 6, "w": 6, "h": 1}}
+], "parametrization": {"selectors": "a=b"}}`
+
+	assert.True(t, suppressDashboardConfigJSONDiff("config_json", testMonitoringDashboardConfigJSON, reordered, nil))
+
+	changed := `{"widgets": [{"text": {"text": "other"}}]}`
+	assert.False(t, suppressDashboardConfigJSONDiff("config_json", testMonitoringDashboardConfigJSON, changed, nil))
+	assert.False(t, suppressDashboardConfigJSONDiff("config_json", "", changed, nil))
+}
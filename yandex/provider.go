@@ -167,8 +167,12 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_compute_placement_group":                          dataSourceYandexComputePlacementGroup(),
 			"yandex_compute_snapshot":                                 dataSourceYandexComputeSnapshot(),
 			"yandex_compute_snapshot_schedule":                        dataSourceYandexComputeSnapshotSchedule(),
+			"yandex_compute_snapshots":                                dataSourceYandexComputeSnapshots(),
 			"yandex_dataproc_cluster":                                 dataSourceYandexDataprocCluster(),
+			"yandex_datatransfer_endpoint":                            dataSourceYandexDatatransferEndpoint(),
+			"yandex_datatransfer_transfer":                            dataSourceYandexDatatransferTransfer(),
 			"yandex_dns_zone":                                         dataSourceYandexDnsZone(),
+			"yandex_dns_zone_export":                                  dataSourceYandexDnsZoneExport(),
 			"yandex_serverless_eventrouter_bus":                       dataSourceYandexServerlessEventrouterBus(),
 			"yandex_serverless_eventrouter_connector":                 dataSourceYandexServerlessEventrouterConnector(),
 			"yandex_serverless_eventrouter_rule":                      dataSourceYandexServerlessEventrouterRule(),
@@ -217,6 +221,8 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_resourcemanager_cloud":                            dataSourceYandexResourceManagerCloud(),
 			"yandex_resourcemanager_folder":                           dataSourceYandexResourceManagerFolder(),
 			"yandex_serverless_container":                             dataSourceYandexServerlessContainer(),
+			"yandex_storage_bucket":                                   dataSourceYandexStorageBucket(),
+			"yandex_storage_object":                                   dataSourceYandexStorageObject(),
 			"yandex_vpc_address":                                      dataSourceYandexVPCAddress(),
 			"yandex_vpc_gateway":                                      dataSourceYandexVPCGateway(),
 			"yandex_vpc_network":                                      dataSourceYandexVPCNetwork(),
@@ -226,10 +232,13 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_vpc_private_endpoint":                             dataSourceYandexVPCPrivateEndpoint(),
 			"yandex_ydb_database_dedicated":                           dataSourceYandexYDBDatabaseDedicated(),
 			"yandex_ydb_database_serverless":                          dataSourceYandexYDBDatabaseServerless(),
+			"yandex_ydb_table":                                        dataSourceYandexYDBTable(),
+			"yandex_ydb_topic":                                        dataSourceYandexYDBTopic(),
 			"yandex_sws_security_profile":                             dataSourceYandexSmartwebsecuritySecurityProfile(),
 			"yandex_sws_advanced_rate_limiter_profile":                dataSourceYandexSmartwebsecurityAdvancedRateLimiterAdvancedRateLimiterProfile(),
 			"yandex_sws_waf_profile":                                  dataSourceYandexSmartwebsecurityWafWafProfile(),
 			"yandex_sws_waf_rule_set_descriptor":                      dataSourceYandexSmartwebsecurityWafRuleSetDescriptor(),
+			"yandex_sws_rule_bundle":                                  dataSourceYandexSmartwebsecurityRuleBundle(),
 			"yandex_smartcaptcha_captcha":                             dataSourceYandexSmartcaptchaCaptcha(),
 		},
 
@@ -238,13 +247,16 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_alb_http_router":                                  resourceYandexALBHTTPRouter(),
 			"yandex_alb_load_balancer":                                resourceYandexALBLoadBalancer(),
 			"yandex_alb_target_group":                                 resourceYandexALBTargetGroup(),
-			"yandex_alb_virtual_host":                                 addPassthroughImport(withALBVirtualHostID(resourceYandexALBVirtualHost())),
+			"yandex_alb_virtual_host":                                 withALBVirtualHostID(resourceYandexALBVirtualHost()),
+			"yandex_alb_virtual_host_route":                           resourceYandexALBVirtualHostRoute(),
 			"yandex_api_gateway":                                      resourceYandexApiGateway(),
 			"yandex_audit_trails_trail":                               resourceYandexAuditTrailsTrail(),
 			"yandex_backup_policy":                                    resourceYandexBackupPolicy(),
 			"yandex_backup_policy_bindings":                           resourceYandexBackupPolicyBindings(),
 			"yandex_container_registry_ip_permission":                 resourceYandexContainerRegistryIPPermission(),
 			"yandex_container_repository_lifecycle_policy":            resourceYandexContainerRepositoryLifecyclePolicy(),
+			"yandex_cdn_cache_prefetch":                               resourceYandexCDNCachePrefetch(),
+			"yandex_cdn_cache_purge":                                  resourceYandexCDNCachePurge(),
 			"yandex_cdn_origin_group":                                 resourceYandexCDNOriginGroup(),
 			"yandex_cdn_resource":                                     resourceYandexCDNResource(),
 			"yandex_cm_certificate":                                   resourceYandexCMCertificate(),
@@ -254,12 +266,14 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_compute_instance_group":                           resourceYandexComputeInstanceGroup(),
 			"yandex_compute_placement_group":                          resourceYandexComputePlacementGroup(),
 			"yandex_compute_snapshot":                                 resourceYandexComputeSnapshot(),
+			"yandex_compute_snapshot_copy":                            resourceYandexComputeSnapshotCopy(),
 			"yandex_compute_snapshot_schedule":                        resourceYandexComputeSnapshotSchedule(),
 			"yandex_dataproc_cluster":                                 resourceYandexDataprocCluster(),
 			"yandex_datatransfer_endpoint":                            resourceYandexDatatransferEndpoint(),
 			"yandex_datatransfer_transfer":                            resourceYandexDatatransferTransfer(),
 			"yandex_dns_recordset":                                    resourceYandexDnsRecordSet(),
 			"yandex_dns_zone":                                         resourceYandexDnsZone(),
+			"yandex_dns_zone_records":                                 resourceYandexDnsZoneRecords(),
 			"yandex_serverless_eventrouter_connector":                 resourceYandexServerlessEventrouterConnector(),
 			"yandex_serverless_eventrouter_rule":                      resourceYandexServerlessEventrouterRule(),
 			"yandex_function":                                         resourceYandexFunction(),
@@ -285,7 +299,10 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_mdb_kafka_cluster":                                resourceYandexMDBKafkaCluster(),
 			"yandex_mdb_kafka_topic":                                  resourceYandexMDBKafkaTopic(),
 			"yandex_mdb_kafka_connector":                              resourceYandexMDBKafkaConnector(),
+			"yandex_mdb_kafka_schema":                                 resourceYandexMDBKafkaSchema(),
+			"yandex_mdb_kafka_schema_registry_subject":                resourceYandexMDBKafkaSchemaRegistrySubject(),
 			"yandex_mdb_kafka_user":                                   resourceYandexMDBKafkaUser(),
+			"yandex_mdb_kafka_user_permission":                        resourceYandexMDBKafkaUserPermission(),
 			"yandex_mdb_mongodb_cluster":                              resourceYandexMDBMongodbCluster(),
 			"yandex_mdb_mysql_cluster":                                resourceYandexMDBMySQLCluster(),
 			"yandex_mdb_mysql_database":                               resourceYandexMDBMySQLDatabase(),
@@ -315,12 +332,17 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_vpc_security_group":                               resourceYandexVPCSecurityGroup(),
 			"yandex_vpc_subnet":                                       resourceYandexVPCSubnet(),
 			"yandex_vpc_private_endpoint":                             resourceYandexVPCPrivateEndpoint(),
+			"yandex_ydb_coordination_node":                            resourceYandexYDBCoordinationNode(),
 			"yandex_ydb_database_dedicated":                           resourceYandexYDBDatabaseDedicated(),
 			"yandex_ydb_database_serverless":                          resourceYandexYDBDatabaseServerless(),
+			"yandex_ydb_document_table":                               resourceYandexYDBDocumentTable(),
+			"yandex_ydb_group":                                        resourceYandexYDBGroup(),
+			"yandex_ydb_permission":                                   resourceYandexYDBPermission(),
 			"yandex_ydb_topic":                                        resourceYandexYDBTopic(),
 			"yandex_ydb_table":                                        resourceYandexYDBTable(),
 			"yandex_ydb_table_changefeed":                             resourceYandexYDBTableChangefeed(),
 			"yandex_ydb_table_index":                                  resourceYandexYDBTableIndex(),
+			"yandex_ydb_user":                                         resourceYandexYDBUser(),
 			"yandex_sws_security_profile":                             resourceYandexSmartwebsecuritySecurityProfile(),
 			"yandex_sws_advanced_rate_limiter_profile":                resourceYandexSmartwebsecurityAdvancedRateLimiterAdvancedRateLimiterProfile(),
 			"yandex_sws_waf_profile":                                  resourceYandexSmartwebsecurityWafWafProfile(),
@@ -4,25 +4,27 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
 )
 
 const yandexALBVirtualHostDefaultTimeout = 5 * time.Minute
 
 func resourceYandexALBVirtualHost() *schema.Resource {
 	return &schema.Resource{
-		Description: "Creates a virtual host that belongs to specified HTTP router and adds the specified routes to it. For more information, see [the official documentation](https://yandex.cloud/docs/application-load-balancer/concepts/http-router).\n",
+		Description: "Creates a virtual host that belongs to specified HTTP router and adds the specified routes to it. For more information, see [the official documentation](https://yandex.cloud/docs/application-load-balancer/concepts/http-router).\n\n~> Named routes that are not listed in the `route` blocks, such as the ones managed by `yandex_alb_virtual_host_route`, are left intact by this resource.\n",
 		Create:      resourceYandexALBVirtualHostCreate,
 		Read:        resourceYandexALBVirtualHostRead,
 		Update:      resourceYandexALBVirtualHostUpdate,
 		Delete:      resourceYandexALBVirtualHostDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceYandexALBVirtualHostImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -59,247 +61,253 @@ func resourceYandexALBVirtualHost() *schema.Resource {
 				Description: routeSchemaDescription,
 				Optional:    true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:        schema.TypeString,
-							Description: routeNameSchemaDescription,
-							Optional:    true,
+					Schema: albRouteSchema(),
+				},
+			},
+			"route_options": routeOptions(),
+		},
+	}
+}
+
+// albRouteSchema returns the attributes of a route, shared by the `route` block of the virtual host
+// and the yandex_alb_virtual_host_route resource.
+func albRouteSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: routeNameSchemaDescription,
+			Optional:    true,
+		},
+		"http_route": {
+			Type:        schema.TypeList,
+			Description: routeHTTPRouteSchemaDescription,
+			MaxItems:    1,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"http_route_action": {
+						Type:        schema.TypeList,
+						Description: routeHTTPRouteActionSchemaDescription,
+						MaxItems:    1,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"backend_group_id": {
+									Type:        schema.TypeString,
+									Description: routeHTTPRouteActionBackendGroupIDSchemaDescription,
+									Required:    true,
+								},
+								"timeout": {
+									Type:             schema.TypeString,
+									Description:      routeHTTPRouteActionTimeoutSchemaDescription,
+									Optional:         true,
+									ValidateFunc:     validateParsableValue(parseDuration),
+									DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
+								},
+								"idle_timeout": {
+									Type:             schema.TypeString,
+									Description:      routeHTTPRouteActionIdleTimeoutSchemaDescription,
+									Optional:         true,
+									ValidateFunc:     validateParsableValue(parseDuration),
+									DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
+								},
+								"prefix_rewrite": {
+									Type:        schema.TypeString,
+									Description: routeHTTPRouteActionPrefixRewriteSchemaDescription,
+									Optional:    true,
+								},
+								regexRewriteSchemaKey: regexRewrite(),
+								"upgrade_types": {
+									Type:        schema.TypeSet,
+									Description: routeHTTPRouteActionUpgradeTypesSchemaDescription,
+									Optional:    true,
+									Elem:        &schema.Schema{Type: schema.TypeString},
+									Set:         schema.HashString,
+								},
+								"host_rewrite": {
+									Type:        schema.TypeString,
+									Description: routeHTTPRouteActionHostRewriteSchemaDescription,
+									Optional:    true,
+								},
+								"auto_host_rewrite": {
+									Type:        schema.TypeBool,
+									Description: routeHTTPRouteActionAutoHostRewriteSchemaDescription,
+									Optional:    true,
+								},
+								rateLimitSchemaKey: rateLimit(),
+							},
 						},
-						"http_route": {
-							Type:        schema.TypeList,
-							Description: routeHTTPRouteSchemaDescription,
-							MaxItems:    1,
-							Optional:    true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"http_route_action": {
-										Type:        schema.TypeList,
-										Description: routeHTTPRouteActionSchemaDescription,
-										MaxItems:    1,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"backend_group_id": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRouteActionBackendGroupIDSchemaDescription,
-													Required:    true,
-												},
-												"timeout": {
-													Type:             schema.TypeString,
-													Description:      routeHTTPRouteActionTimeoutSchemaDescription,
-													Optional:         true,
-													ValidateFunc:     validateParsableValue(parseDuration),
-													DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
-												},
-												"idle_timeout": {
-													Type:             schema.TypeString,
-													Description:      routeHTTPRouteActionIdleTimeoutSchemaDescription,
-													Optional:         true,
-													ValidateFunc:     validateParsableValue(parseDuration),
-													DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
-												},
-												"prefix_rewrite": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRouteActionPrefixRewriteSchemaDescription,
-													Optional:    true,
-												},
-												regexRewriteSchemaKey: regexRewrite(),
-												"upgrade_types": {
-													Type:        schema.TypeSet,
-													Description: routeHTTPRouteActionUpgradeTypesSchemaDescription,
-													Optional:    true,
-													Elem:        &schema.Schema{Type: schema.TypeString},
-													Set:         schema.HashString,
-												},
-												"host_rewrite": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRouteActionHostRewriteSchemaDescription,
-													Optional:    true,
-												},
-												"auto_host_rewrite": {
-													Type:        schema.TypeBool,
-													Description: routeHTTPRouteActionAutoHostRewriteSchemaDescription,
-													Optional:    true,
-												},
-												rateLimitSchemaKey: rateLimit(),
-											},
-										},
-									},
-									"redirect_action": {
-										Type:        schema.TypeList,
-										Description: routeHTTPRedirectActionSchemaDescription,
-										MaxItems:    1,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"replace_scheme": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRedirectActionReplaceSchemeSchemaDescription,
-													Optional:    true,
-												},
-												"replace_host": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRedirectActionReplaceHostSchemaDescription,
-													Optional:    true,
-												},
-												"replace_port": {
-													Type:        schema.TypeInt,
-													Description: routeHTTPRedirectActionReplacePortSchemaDescription,
-													Optional:    true,
-												},
-												"remove_query": {
-													Type:        schema.TypeBool,
-													Description: routeHTTPRedirectActionRemoveQuerySchemaDescription,
-													Optional:    true,
-												},
-												"response_code": {
-													Type:             schema.TypeString,
-													Description:      routeHTTPRedirectActionResponseCodeSchemaDescription,
-													Default:          "moved_permanently",
-													Optional:         true,
-													DiffSuppressFunc: CaseInsensitive,
-												},
-												"replace_path": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRedirectActionReplacePathSchemaDescription,
-													Optional:    true,
-												},
-												"replace_prefix": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRedirectActionReplacePrefixSchemaDescription,
-													Optional:    true,
-												},
-											},
-										},
-									},
-									"direct_response_action": {
-										Type:        schema.TypeList,
-										Description: routeHTTPDirectResponseActionSchemaDescription,
-										MaxItems:    1,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"status": {
-													Type:         schema.TypeInt,
-													Description:  routeHTTPDirectResponseActionStatusSchemaDescription,
-													ValidateFunc: validation.IntBetween(100, 599),
-													Optional:     true,
-												},
-												"body": {
-													Type:        schema.TypeString,
-													Description: routeHTTPDirectResponseActionBodySchemaDescription,
-													Optional:    true,
-												},
-											},
-										},
-									},
-									"http_match": {
-										Type:        schema.TypeList,
-										Description: routeHTTPMatchSchemaDescription,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"http_method": {
-													Type:        schema.TypeSet,
-													Description: routeHTTPMatchMethodSchemaDescription,
-													Optional:    true,
-													Elem:        &schema.Schema{Type: schema.TypeString},
-													Set:         schema.HashString,
-												},
-												"path": stringMatch(),
-											},
-										},
-									},
+					},
+					"redirect_action": {
+						Type:        schema.TypeList,
+						Description: routeHTTPRedirectActionSchemaDescription,
+						MaxItems:    1,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"replace_scheme": {
+									Type:        schema.TypeString,
+									Description: routeHTTPRedirectActionReplaceSchemeSchemaDescription,
+									Optional:    true,
+								},
+								"replace_host": {
+									Type:        schema.TypeString,
+									Description: routeHTTPRedirectActionReplaceHostSchemaDescription,
+									Optional:    true,
+								},
+								"replace_port": {
+									Type:        schema.TypeInt,
+									Description: routeHTTPRedirectActionReplacePortSchemaDescription,
+									Optional:    true,
+								},
+								"remove_query": {
+									Type:        schema.TypeBool,
+									Description: routeHTTPRedirectActionRemoveQuerySchemaDescription,
+									Optional:    true,
+								},
+								"response_code": {
+									Type:             schema.TypeString,
+									Description:      routeHTTPRedirectActionResponseCodeSchemaDescription,
+									Default:          "moved_permanently",
+									Optional:         true,
+									DiffSuppressFunc: CaseInsensitive,
+								},
+								"replace_path": {
+									Type:        schema.TypeString,
+									Description: routeHTTPRedirectActionReplacePathSchemaDescription,
+									Optional:    true,
+								},
+								"replace_prefix": {
+									Type:        schema.TypeString,
+									Description: routeHTTPRedirectActionReplacePrefixSchemaDescription,
+									Optional:    true,
 								},
 							},
 						},
-						"grpc_route": {
-							Type:        schema.TypeList,
-							Description: routeGRPCRouteSchemaDescription,
-							MaxItems:    1,
-							Optional:    true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"grpc_match": {
-										Type:        schema.TypeList,
-										Description: routeGRPCRouteMatchSchemaDescription,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"fqmn": stringMatch(),
-											},
-										},
-									},
-									"grpc_route_action": {
-										Type:        schema.TypeList,
-										Description: routeGRPCRouteActionSchemaDescription,
-										MaxItems:    1,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"backend_group_id": {
-													Type:        schema.TypeString,
-													Description: routeGRPCRouteActionBackendGroupIDSchemaDescription,
-													Required:    true,
-												},
-												"max_timeout": {
-													Type:             schema.TypeString,
-													Description:      routeGRPCRouteActionMaxTimeoutSchemaDescription,
-													Optional:         true,
-													ValidateFunc:     validateParsableValue(parseDuration),
-													DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
-												},
-												"idle_timeout": {
-													Type:             schema.TypeString,
-													Description:      routeGRPCRouteActionIdleTimeoutSchemaDescription,
-													Optional:         true,
-													ValidateFunc:     validateParsableValue(parseDuration),
-													DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
-												},
-												"host_rewrite": {
-													Type:        schema.TypeString,
-													Description: routeGRPCRouteActionHostRewriteSchemaDescription,
-													Optional:    true,
-												},
-												"auto_host_rewrite": {
-													Type:        schema.TypeBool,
-													Description: routeGRPCRouteActionAutoHostRewriteSchemaDescription,
-													Optional:    true,
-												},
-												rateLimitSchemaKey: rateLimit(),
-											},
-										},
-									},
-									"grpc_status_response_action": {
-										Type:        schema.TypeList,
-										Description: routeGRPCStatusResponseActionSchemaDescription,
-										MaxItems:    1,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"status": {
-													Type:             schema.TypeString,
-													Description:      routeGRPCStatusResponseActionStatusSchemaDescription,
-													Optional:         true,
-													DiffSuppressFunc: CaseInsensitive,
-												},
-											},
-										},
-									},
+					},
+					"direct_response_action": {
+						Type:        schema.TypeList,
+						Description: routeHTTPDirectResponseActionSchemaDescription,
+						MaxItems:    1,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"status": {
+									Type:         schema.TypeInt,
+									Description:  routeHTTPDirectResponseActionStatusSchemaDescription,
+									ValidateFunc: validation.IntBetween(100, 599),
+									Optional:     true,
+								},
+								"body": {
+									Type:        schema.TypeString,
+									Description: routeHTTPDirectResponseActionBodySchemaDescription,
+									Optional:    true,
 								},
 							},
 						},
-						disableSecurityProfileSchemaKey: {
-							Type:        schema.TypeBool,
-							Description: disableSecurityProfileSchemaDescription,
-							Optional:    true,
+					},
+					"http_match": {
+						Type:        schema.TypeList,
+						Description: routeHTTPMatchSchemaDescription,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"http_method": {
+									Type:        schema.TypeSet,
+									Description: routeHTTPMatchMethodSchemaDescription,
+									Optional:    true,
+									Elem:        &schema.Schema{Type: schema.TypeString},
+									Set:         schema.HashString,
+								},
+								"path": stringMatch(),
+							},
+						},
+					},
+				},
+			},
+		},
+		"grpc_route": {
+			Type:        schema.TypeList,
+			Description: routeGRPCRouteSchemaDescription,
+			MaxItems:    1,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"grpc_match": {
+						Type:        schema.TypeList,
+						Description: routeGRPCRouteMatchSchemaDescription,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"fqmn": stringMatch(),
+							},
+						},
+					},
+					"grpc_route_action": {
+						Type:        schema.TypeList,
+						Description: routeGRPCRouteActionSchemaDescription,
+						MaxItems:    1,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"backend_group_id": {
+									Type:        schema.TypeString,
+									Description: routeGRPCRouteActionBackendGroupIDSchemaDescription,
+									Required:    true,
+								},
+								"max_timeout": {
+									Type:             schema.TypeString,
+									Description:      routeGRPCRouteActionMaxTimeoutSchemaDescription,
+									Optional:         true,
+									ValidateFunc:     validateParsableValue(parseDuration),
+									DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
+								},
+								"idle_timeout": {
+									Type:             schema.TypeString,
+									Description:      routeGRPCRouteActionIdleTimeoutSchemaDescription,
+									Optional:         true,
+									ValidateFunc:     validateParsableValue(parseDuration),
+									DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
+								},
+								"host_rewrite": {
+									Type:        schema.TypeString,
+									Description: routeGRPCRouteActionHostRewriteSchemaDescription,
+									Optional:    true,
+								},
+								"auto_host_rewrite": {
+									Type:        schema.TypeBool,
+									Description: routeGRPCRouteActionAutoHostRewriteSchemaDescription,
+									Optional:    true,
+								},
+								rateLimitSchemaKey: rateLimit(),
+							},
+						},
+					},
+					"grpc_status_response_action": {
+						Type:        schema.TypeList,
+						Description: routeGRPCStatusResponseActionSchemaDescription,
+						MaxItems:    1,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"status": {
+									Type:             schema.TypeString,
+									Description:      routeGRPCStatusResponseActionStatusSchemaDescription,
+									Optional:         true,
+									DiffSuppressFunc: CaseInsensitive,
+								},
+							},
 						},
-						"route_options": routeOptions(),
 					},
 				},
 			},
-			"route_options": routeOptions(),
 		},
+		disableSecurityProfileSchemaKey: {
+			Type:        schema.TypeBool,
+			Description: disableSecurityProfileSchemaDescription,
+			Optional:    true,
+		},
+		"route_options": routeOptions(),
 	}
 }
 
@@ -555,7 +563,7 @@ func resourceYandexALBVirtualHostRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	routes, err := flattenALBRoutes(virtualHost.Routes)
+	routes, err := flattenALBRoutes(filterALBOwnedRoutes(virtualHost.Routes, albVirtualHostOwnedRouteNames(d)))
 	if err != nil {
 		return err
 	}
@@ -607,6 +615,21 @@ func resourceYandexALBVirtualHostUpdate(d *schema.ResourceData, meta interface{}
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
+	mutexKV := globallock.GetMutexKV()
+	lockKey := albVirtualHostLockKey(req.HttpRouterId, req.VirtualHostName)
+	mutexKV.Lock(lockKey)
+	defer mutexKV.Unlock(lockKey)
+
+	// keep the routes managed by yandex_alb_virtual_host_route
+	current, err := config.sdk.ApplicationLoadBalancer().VirtualHost().Get(ctx, &apploadbalancer.GetVirtualHostRequest{
+		HttpRouterId:    req.HttpRouterId,
+		VirtualHostName: req.VirtualHostName,
+	})
+	if err != nil {
+		return fmt.Errorf("Error while getting Application Virtual Host %q: %w", d.Id(), err)
+	}
+	req.Routes = mergeALBExternalRoutes(current.GetRoutes(), req.Routes, albVirtualHostOwnedRouteNames(d))
+
 	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().VirtualHost().Update(ctx, req))
 	if err != nil {
 		return fmt.Errorf("Error while requesting API to update Application Virtual Host %q: %w", d.Id(), err)
@@ -668,6 +691,36 @@ func buildALBVirtualHostUpdateRequest(d *schema.ResourceData) (*apploadbalancer.
 	return req, nil
 }
 
+func resourceYandexALBVirtualHostImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected <http_router_id>/<virtual_host_name>", d.Id())
+	}
+
+	virtualHost, err := config.sdk.ApplicationLoadBalancer().VirtualHost().Get(config.Context(), &apploadbalancer.GetVirtualHostRequest{
+		HttpRouterId:    parts[0],
+		VirtualHostName: parts[1],
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error while getting Application Virtual Host %q: %w", d.Id(), err)
+	}
+
+	// all routes are imported, since there is no way to tell which of them are managed by yandex_alb_virtual_host_route
+	routes, err := flattenALBRoutes(virtualHost.Routes)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("http_router_id", parts[0])
+	d.Set("name", parts[1])
+	if err := d.Set("route", routes); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceYandexALBVirtualHostDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -0,0 +1,400 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
+)
+
+func resourceYandexALBVirtualHostRoute() *schema.Resource {
+	routeSchema := albRouteSchema()
+	routeSchema["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Name of the route. It identifies the route in the virtual host.",
+		Required:    true,
+		ForceNew:    true,
+	}
+	routeSchema["http_router_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The ID of the HTTP router to which the virtual host belongs.",
+		Required:    true,
+		ForceNew:    true,
+	}
+	routeSchema["virtual_host_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the virtual host to add the route to.",
+		Required:    true,
+		ForceNew:    true,
+	}
+	routeSchema["position"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Position of the route in the virtual host. Routes are matched in order, so the position defines the priority of the route. If not specified, the route is added to the end of the list, and its position is kept on updates.",
+		MaxItems:    1,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"before": {
+					Type:         schema.TypeString,
+					Description:  "Name of the route to place this route before.",
+					Optional:     true,
+					ExactlyOneOf: []string{"position.0.before", "position.0.after", "position.0.index"},
+				},
+				"after": {
+					Type:        schema.TypeString,
+					Description: "Name of the route to place this route after.",
+					Optional:    true,
+				},
+				"index": {
+					Type:         schema.TypeInt,
+					Description:  "Zero-based index of the route in the list of routes. If it exceeds the number of routes, the route is added to the end.",
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Manages a single route of an Application Load Balancer virtual host, so that routes of a shared virtual host can be managed separately. For more information, see [the official documentation](https://yandex.cloud/docs/application-load-balancer/concepts/http-router).\n\n~> Routes of the `yandex_alb_virtual_host` resource are managed by their names. Named routes that are not listed in its `route` blocks, including the ones managed by `yandex_alb_virtual_host_route`, are left intact by the virtual host resource.\n\n~> Exactly one type of routes `http_route` or `grpc_route` should be specified.\n",
+
+		Create: resourceYandexALBVirtualHostRouteCreate,
+		Read:   resourceYandexALBVirtualHostRouteRead,
+		Update: resourceYandexALBVirtualHostRouteUpdate,
+		Delete: resourceYandexALBVirtualHostRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceYandexALBVirtualHostRouteImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexALBVirtualHostDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexALBVirtualHostDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexALBVirtualHostDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: routeSchema,
+	}
+}
+
+// albVirtualHostLockKey is the key of the global lock taken while the routes of a virtual host are modified.
+func albVirtualHostLockKey(httpRouterID, virtualHostName string) string {
+	return "alb-virtual-host/" + httpRouterID + "/" + virtualHostName
+}
+
+type albRoutePosition struct {
+	before string
+	after  string
+	index  int
+	set    bool
+}
+
+func expandALBRoutePosition(d *schema.ResourceData) albRoutePosition {
+	if _, ok := d.GetOk("position"); !ok {
+		return albRoutePosition{}
+	}
+
+	position := albRoutePosition{set: true, index: -1}
+	if v, ok := d.GetOk("position.0.before"); ok {
+		position.before = v.(string)
+	} else if v, ok := d.GetOk("position.0.after"); ok {
+		position.after = v.(string)
+	} else {
+		position.index = d.Get("position.0.index").(int)
+	}
+	return position
+}
+
+// placeALBRoute returns the routes with the route inserted at the position. An existing route with the same name
+// is replaced, keeping its index if the position is not set.
+func placeALBRoute(routes []*apploadbalancer.Route, route *apploadbalancer.Route, position albRoutePosition) ([]*apploadbalancer.Route, error) {
+	result := make([]*apploadbalancer.Route, 0, len(routes)+1)
+	current := -1
+	for i, r := range routes {
+		if r.GetName() == route.GetName() {
+			current = i
+			continue
+		}
+		result = append(result, r)
+	}
+
+	indexOf := func(name string) int {
+		for i, r := range result {
+			if r.GetName() == name {
+				return i
+			}
+		}
+		return -1
+	}
+
+	index := len(result)
+	switch {
+	case position.before != "":
+		if index = indexOf(position.before); index < 0 {
+			return nil, fmt.Errorf("route %q to place route %q before is not found", position.before, route.GetName())
+		}
+	case position.after != "":
+		if index = indexOf(position.after); index < 0 {
+			return nil, fmt.Errorf("route %q to place route %q after is not found", position.after, route.GetName())
+		}
+		index++
+	case position.set:
+		if position.index < len(result) {
+			index = position.index
+		}
+	case current >= 0:
+		index = current
+	}
+
+	result = append(result, nil)
+	copy(result[index+1:], result[index:])
+	result[index] = route
+	return result, nil
+}
+
+func updateALBVirtualHostRoutes(ctx context.Context, config *Config, httpRouterID, virtualHostName string, routes []*apploadbalancer.Route) error {
+	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().VirtualHost().Update(ctx, &apploadbalancer.UpdateVirtualHostRequest{
+		HttpRouterId:    httpRouterID,
+		VirtualHostName: virtualHostName,
+		Routes:          routes,
+		UpdateMask: &field_mask.FieldMask{
+			Paths: []string{"routes"},
+		},
+	}))
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to update routes of Application Virtual Host %q: %w", virtualHostName, err)
+	}
+
+	if err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("Error updating routes of Application Virtual Host %q: %w", virtualHostName, err)
+	}
+	return nil
+}
+
+func resourceYandexALBVirtualHostRoutePut(ctx context.Context, d *schema.ResourceData, config *Config) error {
+	httpRouterID := d.Get("http_router_id").(string)
+	virtualHostName := d.Get("virtual_host_name").(string)
+
+	route, err := expandALBRoute(d, "")
+	if err != nil {
+		return fmt.Errorf("Error expanding Application Virtual Host route: %w", err)
+	}
+
+	mutexKV := globallock.GetMutexKV()
+	lockKey := albVirtualHostLockKey(httpRouterID, virtualHostName)
+	mutexKV.Lock(lockKey)
+	defer mutexKV.Unlock(lockKey)
+
+	virtualHost, err := config.sdk.ApplicationLoadBalancer().VirtualHost().Get(ctx, &apploadbalancer.GetVirtualHostRequest{
+		HttpRouterId:    httpRouterID,
+		VirtualHostName: virtualHostName,
+	})
+	if err != nil {
+		return fmt.Errorf("Error while getting Application Virtual Host %q: %w", virtualHostName, err)
+	}
+
+	routes, err := placeALBRoute(virtualHost.GetRoutes(), route, expandALBRoutePosition(d))
+	if err != nil {
+		return err
+	}
+
+	return updateALBVirtualHostRoutes(ctx, config, httpRouterID, virtualHostName, routes)
+}
+
+func resourceYandexALBVirtualHostRouteCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Creating Application Virtual Host route %q", d.Get("name"))
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	if err := resourceYandexALBVirtualHostRoutePut(ctx, d, config); err != nil {
+		return err
+	}
+
+	d.SetId(strings.Join([]string{d.Get("http_router_id").(string), d.Get("virtual_host_name").(string), d.Get("name").(string)}, "/"))
+
+	log.Printf("[DEBUG] Finished creating Application Virtual Host route %q", d.Id())
+	return resourceYandexALBVirtualHostRouteRead(d, meta)
+}
+
+func resourceYandexALBVirtualHostRouteRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Reading Application Virtual Host route %q", d.Id())
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	virtualHost, err := config.sdk.ApplicationLoadBalancer().VirtualHost().Get(ctx, &apploadbalancer.GetVirtualHostRequest{
+		HttpRouterId:    d.Get("http_router_id").(string),
+		VirtualHostName: d.Get("virtual_host_name").(string),
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Application Virtual Host %q", d.Get("virtual_host_name").(string)))
+	}
+
+	var route *apploadbalancer.Route
+	for _, r := range virtualHost.GetRoutes() {
+		if r.GetName() == d.Get("name").(string) {
+			route = r
+			break
+		}
+	}
+	if route == nil {
+		log.Printf("[WARN] Application Virtual Host route %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	routes, err := flattenALBRoutes([]*apploadbalancer.Route{route})
+	if err != nil {
+		return err
+	}
+	for _, k := range []string{"http_route", "grpc_route", disableSecurityProfileSchemaKey, "route_options"} {
+		if err := d.Set(k, routes[0][k]); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Finished reading Application Virtual Host route %q", d.Id())
+	return nil
+}
+
+func resourceYandexALBVirtualHostRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Updating Application Virtual Host route %q", d.Id())
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	if err := resourceYandexALBVirtualHostRoutePut(ctx, d, config); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished updating Application Virtual Host route %q", d.Id())
+	return resourceYandexALBVirtualHostRouteRead(d, meta)
+}
+
+func resourceYandexALBVirtualHostRouteDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Deleting Application Virtual Host route %q", d.Id())
+
+	httpRouterID := d.Get("http_router_id").(string)
+	virtualHostName := d.Get("virtual_host_name").(string)
+
+	mutexKV := globallock.GetMutexKV()
+	lockKey := albVirtualHostLockKey(httpRouterID, virtualHostName)
+	mutexKV.Lock(lockKey)
+	defer mutexKV.Unlock(lockKey)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().VirtualHost().RemoveRoute(ctx, &apploadbalancer.RemoveRouteRequest{
+		HttpRouterId:    httpRouterID,
+		VirtualHostName: virtualHostName,
+		RouteName:       d.Get("name").(string),
+	}))
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Application Virtual Host route %q", d.Get("name").(string)))
+	}
+
+	if err := op.Wait(ctx); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Application Virtual Host route %q", d.Id())
+	return nil
+}
+
+func resourceYandexALBVirtualHostRouteImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected <http_router_id>/<virtual_host_name>/<route_name>", d.Id())
+	}
+
+	d.Set("http_router_id", parts[0])
+	d.Set("virtual_host_name", parts[1])
+	d.Set("name", parts[2])
+	return []*schema.ResourceData{d}, nil
+}
+
+// albVirtualHostOwnedRouteNames returns the names of the routes listed in the `route` blocks of the virtual host,
+// both before and after the change.
+func albVirtualHostOwnedRouteNames(d *schema.ResourceData) map[string]bool {
+	names := make(map[string]bool)
+	oldRoutes, newRoutes := d.GetChange("route")
+	for _, routes := range []interface{}{oldRoutes, newRoutes} {
+		for _, r := range routes.([]interface{}) {
+			if route, ok := r.(map[string]interface{}); ok {
+				names[route["name"].(string)] = true
+			}
+		}
+	}
+	return names
+}
+
+// isExternalALBRoute reports whether the route is managed outside of the `route` blocks of the virtual host.
+// Routes without a name can't be referenced by yandex_alb_virtual_host_route, so they are always owned by the virtual host.
+func isExternalALBRoute(route *apploadbalancer.Route, owned map[string]bool) bool {
+	return route.GetName() != "" && !owned[route.GetName()]
+}
+
+// filterALBOwnedRoutes drops the externally managed routes.
+func filterALBOwnedRoutes(routes []*apploadbalancer.Route, owned map[string]bool) []*apploadbalancer.Route {
+	var result []*apploadbalancer.Route
+	for _, route := range routes {
+		if !isExternalALBRoute(route, owned) {
+			result = append(result, route)
+		}
+	}
+	return result
+}
+
+// mergeALBExternalRoutes returns the routes of the virtual host with the externally managed routes of the current
+// virtual host kept after the same owned route they follow now, or at the beginning if no owned route precedes them.
+func mergeALBExternalRoutes(current, routes []*apploadbalancer.Route, owned map[string]bool) []*apploadbalancer.Route {
+	var head []*apploadbalancer.Route
+	following := make(map[string][]*apploadbalancer.Route)
+	anchor := ""
+	for _, route := range current {
+		if !isExternalALBRoute(route, owned) {
+			anchor = route.GetName()
+			continue
+		}
+		if anchor == "" {
+			head = append(head, route)
+		} else {
+			following[anchor] = append(following[anchor], route)
+		}
+	}
+
+	result := append([]*apploadbalancer.Route{}, head...)
+	placed := make(map[string]bool)
+	for _, route := range routes {
+		result = append(result, route)
+		if name := route.GetName(); name != "" && !placed[name] {
+			result = append(result, following[name]...)
+			placed[name] = true
+		}
+	}
+	// external routes following an owned route that was removed go to the end
+	for _, route := range current {
+		if name := route.GetName(); !isExternalALBRoute(route, owned) && name != "" && !placed[name] {
+			result = append(result, following[name]...)
+			placed[name] = true
+		}
+	}
+	return result
+}
@@ -0,0 +1,159 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+)
+
+func testALBRoutes(names ...string) []*apploadbalancer.Route {
+	routes := make([]*apploadbalancer.Route, 0, len(names))
+	for _, name := range names {
+		routes = append(routes, &apploadbalancer.Route{Name: name})
+	}
+	return routes
+}
+
+func testALBRouteNames(routes []*apploadbalancer.Route) []string {
+	names := make([]string, 0, len(routes))
+	for _, route := range routes {
+		names = append(names, route.GetName())
+	}
+	return names
+}
+
+func TestPlaceALBRoute(t *testing.T) {
+	tests := []struct {
+		name     string
+		routes   []string
+		route    string
+		position albRoutePosition
+		expected []string
+		err      bool
+	}{
+		{
+			name:     "append",
+			routes:   []string{"a", "b"},
+			route:    "c",
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:     "keep index",
+			routes:   []string{"a", "b", "c"},
+			route:    "b",
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:     "before",
+			routes:   []string{"a", "b"},
+			route:    "c",
+			position: albRoutePosition{before: "a", set: true, index: -1},
+			expected: []string{"c", "a", "b"},
+		},
+		{
+			name:     "after",
+			routes:   []string{"a", "b"},
+			route:    "c",
+			position: albRoutePosition{after: "a", set: true, index: -1},
+			expected: []string{"a", "c", "b"},
+		},
+		{
+			name:     "move after",
+			routes:   []string{"a", "b", "c"},
+			route:    "a",
+			position: albRoutePosition{after: "c", set: true, index: -1},
+			expected: []string{"b", "c", "a"},
+		},
+		{
+			name:     "index",
+			routes:   []string{"a", "b", "c"},
+			route:    "c",
+			position: albRoutePosition{index: 0, set: true},
+			expected: []string{"c", "a", "b"},
+		},
+		{
+			name:     "index out of range",
+			routes:   []string{"a", "b"},
+			route:    "c",
+			position: albRoutePosition{index: 10, set: true},
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:     "missing anchor",
+			routes:   []string{"a", "b"},
+			route:    "c",
+			position: albRoutePosition{before: "d", set: true, index: -1},
+			err:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			routes, err := placeALBRoute(testALBRoutes(tt.routes...), &apploadbalancer.Route{Name: tt.route}, tt.position)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, testALBRouteNames(routes))
+		})
+	}
+}
+
+func TestFilterALBOwnedRoutes(t *testing.T) {
+	owned := map[string]bool{"a": true, "c": true}
+	routes := filterALBOwnedRoutes(testALBRoutes("ext-1", "a", "", "ext-2", "c"), owned)
+	assert.Equal(t, []string{"a", "", "c"}, testALBRouteNames(routes))
+}
+
+func TestMergeALBExternalRoutes(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  []string
+		routes   []string
+		owned    []string
+		expected []string
+	}{
+		{
+			name:     "no external routes",
+			current:  []string{"a", "b"},
+			routes:   []string{"b", "a"},
+			owned:    []string{"a", "b"},
+			expected: []string{"b", "a"},
+		},
+		{
+			name:     "keep position",
+			current:  []string{"ext-1", "a", "ext-2", "b"},
+			routes:   []string{"a", "b", "c"},
+			owned:    []string{"a", "b", "c"},
+			expected: []string{"ext-1", "a", "ext-2", "b", "c"},
+		},
+		{
+			name:     "follow reordered route",
+			current:  []string{"a", "ext-1", "b"},
+			routes:   []string{"b", "a"},
+			owned:    []string{"a", "b"},
+			expected: []string{"b", "a", "ext-1"},
+		},
+		{
+			name:     "removed route",
+			current:  []string{"a", "ext-1", "b", "ext-2"},
+			routes:   []string{"b"},
+			owned:    []string{"a", "b"},
+			expected: []string{"b", "ext-2", "ext-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owned := make(map[string]bool)
+			for _, name := range tt.owned {
+				owned[name] = true
+			}
+			routes := mergeALBExternalRoutes(testALBRoutes(tt.current...), testALBRoutes(tt.routes...), owned)
+			assert.Equal(t, tt.expected, testALBRouteNames(routes))
+		})
+	}
+}
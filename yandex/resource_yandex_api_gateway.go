@@ -26,6 +26,10 @@ func resourceYandexApiGateway() *schema.Resource {
 		Update:      resourceYandexApiGatewayUpdate,
 		Delete:      resourceYandexApiGatewayDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexApiGatewayImportState,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexApiGatewayDefaultTimeout),
 			Update: schema.DefaultTimeout(yandexApiGatewayDefaultTimeout),
@@ -460,6 +464,21 @@ func resourceYandexApiGatewayRead(d *schema.ResourceData, meta interface{}) erro
 	return flattenYandexApiGateway(d, apiGateway, false)
 }
 
+// resourceYandexApiGatewayImportState restores the OpenAPI specification, which is not read back by Read.
+func resourceYandexApiGatewayImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	resp, err := config.sdk.Serverless().APIGateway().ApiGateway().GetOpenapiSpec(ctx, &apigateway.GetOpenapiSpecRequest{
+		ApiGatewayId: d.Id(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error while requesting API to get specification of Yandex Cloud API Gateway %q: %s", d.Id(), err)
+	}
+	d.Set("spec", resp.OpenapiSpec)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceYandexApiGatewayDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -87,9 +87,9 @@ func TestAccYandexAPIGateway_basic(t *testing.T) {
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testYandexAPIGatewayDestroy,
-		Steps: []resource.TestStep{
+		Steps: append([]resource.TestStep{
 			basicYandexAPIGatewayTestStep(apiGatewayName, apiGatewayDesc, labelKey, labelValue, spec, &apiGateway),
-		},
+		}, apiGatewayImportSteps(testYandexAPIGatewayBasic(apiGatewayName, apiGatewayDesc, labelKey, labelValue, spec))...),
 	})
 }
 
@@ -111,10 +111,10 @@ func TestAccYandexAPIGateway_update(t *testing.T) {
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testYandexAPIGatewayDestroy,
-		Steps: []resource.TestStep{
+		Steps: append([]resource.TestStep{
 			basicYandexAPIGatewayTestStep(apiGatewayName, apiGatewayDesc, labelKey, labelValue, spec, &apiGateway),
 			basicYandexAPIGatewayTestStep(apiGatewayNameUpdated, apiGatewayDescUpdated, labelKeyUpdated, labelValueUpdated, specUpdated, &apiGateway),
-		},
+		}, apiGatewayImportSteps(testYandexAPIGatewayBasic(apiGatewayNameUpdated, apiGatewayDescUpdated, labelKeyUpdated, labelValueUpdated, specUpdated))...),
 	})
 }
 
@@ -626,6 +626,22 @@ func basicYandexAPIGatewayTestStep(apiGatewayName, apiGatewayDesc, labelKey, lab
 	}
 }
 
+// apiGatewayImportSteps import the API gateway and check that the plan of config is empty afterwards.
+func apiGatewayImportSteps(config string) []resource.TestStep {
+	return []resource.TestStep{
+		{
+			ResourceName:       apiGatewayResource,
+			ImportState:        true,
+			ImportStateVerify:  true,
+			ImportStatePersist: true,
+		},
+		{
+			Config:   config,
+			PlanOnly: true,
+		},
+	}
+}
+
 func testYandexAPIGatewayDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -0,0 +1,73 @@
+package yandex
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/cdn/v1"
+)
+
+const yandexCDNCachePrefetchDefaultTimeout = 30 * time.Minute
+
+func resourceYandexCDNCachePrefetch() *schema.Resource {
+	resourceSchema := cdnCacheActionSchema()
+	resourceSchema["paths"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Paths of the files to load into the cache.",
+		Required:    true,
+		ForceNew:    true,
+		MinItems:    1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validateCDNCachePath,
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Loads files into the cache of a [Yandex Cloud CDN Resource](https://yandex.cloud/docs/cdn/concepts/resource) in advance. The files are loaded when the resource is created, and again every time `paths` or `triggers` are changed. Destroying the resource does nothing.\n",
+
+		Create: resourceYandexCDNCachePrefetchCreate,
+		Read:   resourceYandexCDNCacheActionRead,
+		Delete: resourceYandexCDNCacheActionDelete,
+
+		SchemaVersion: 0,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexCDNCachePrefetchDefaultTimeout),
+		},
+
+		Schema: resourceSchema,
+	}
+}
+
+func resourceYandexCDNCachePrefetchCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	req := &cdn.PrefetchCacheRequest{
+		ResourceId: d.Get("resource_id").(string),
+		Paths:      expandStringSlice(d.Get("paths").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Prefetching cache of CDN resource %q, paths: %v", req.ResourceId, req.Paths)
+
+	op, err := config.sdk.WrapOperation(config.sdk.CDN().Cache().Prefetch(ctx, req))
+	if err != nil {
+		return cdnCacheOperationError("prefetching", req.ResourceId, err)
+	}
+
+	d.SetId(op.Id())
+	d.Set("operation_id", op.Id())
+
+	if err := op.Wait(ctx); err != nil {
+		d.SetId("")
+		return cdnCacheOperationError("prefetching", req.ResourceId, err)
+	}
+
+	log.Printf("[DEBUG] Finished prefetching cache of CDN resource %q", req.ResourceId)
+	return nil
+}
@@ -0,0 +1,82 @@
+package yandex
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/cdn/v1"
+)
+
+const yandexCDNCachePurgeDefaultTimeout = 30 * time.Minute
+
+func resourceYandexCDNCachePurge() *schema.Resource {
+	resourceSchema := cdnCacheActionSchema()
+	resourceSchema["paths"] = &schema.Schema{
+		Type:         schema.TypeList,
+		Description:  "Paths of the files to remove from the cache. The asterisk (`*`) may be used as a wildcard character that substitutes any number of characters.",
+		Optional:     true,
+		ForceNew:     true,
+		MinItems:     1,
+		ExactlyOneOf: []string{"paths", "purge_all"},
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validateCDNCachePath,
+		},
+	}
+	resourceSchema["purge_all"] = &schema.Schema{
+		Type:         schema.TypeBool,
+		Description:  "Purge the whole cache of the CDN resource. Must be `true` if set.",
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validateCDNCachePurgeAll,
+	}
+
+	return &schema.Resource{
+		Description: "Purges the cache of a [Yandex Cloud CDN Resource](https://yandex.cloud/docs/cdn/concepts/resource). The cache is purged when the resource is created, and again every time `paths` or `triggers` are changed. Destroying the resource does nothing.\n",
+
+		Create: resourceYandexCDNCachePurgeCreate,
+		Read:   resourceYandexCDNCacheActionRead,
+		Delete: resourceYandexCDNCacheActionDelete,
+
+		SchemaVersion: 0,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexCDNCachePurgeDefaultTimeout),
+		},
+
+		Schema: resourceSchema,
+	}
+}
+
+func resourceYandexCDNCachePurgeCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	// an empty list of paths purges the whole cache
+	req := &cdn.PurgeCacheRequest{
+		ResourceId: d.Get("resource_id").(string),
+		Paths:      expandStringSlice(d.Get("paths").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Purging cache of CDN resource %q, paths: %v", req.ResourceId, req.Paths)
+
+	op, err := config.sdk.WrapOperation(config.sdk.CDN().Cache().Purge(ctx, req))
+	if err != nil {
+		return cdnCacheOperationError("purging", req.ResourceId, err)
+	}
+
+	d.SetId(op.Id())
+	d.Set("operation_id", op.Id())
+
+	if err := op.Wait(ctx); err != nil {
+		d.SetId("")
+		return cdnCacheOperationError("purging", req.ResourceId, err)
+	}
+
+	log.Printf("[DEBUG] Finished purging cache of CDN resource %q", req.ResourceId)
+	return nil
+}
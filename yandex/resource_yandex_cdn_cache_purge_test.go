@@ -0,0 +1,107 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCDNCacheOperationError(t *testing.T) {
+	st, err := status.New(codes.InvalidArgument, "some paths were not purged").WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "paths[1]", Description: "invalid path"},
+		},
+	})
+	require.NoError(t, err)
+
+	err = cdnCacheOperationError("purging", "cdn-resource-id", st.Err())
+	assert.Contains(t, err.Error(), `error while purging cache of CDN resource "cdn-resource-id"`)
+	assert.Contains(t, err.Error(), "some paths were not purged")
+	assert.Contains(t, err.Error(), "paths[1]: invalid path")
+
+	err = cdnCacheOperationError("prefetching", "cdn-resource-id", status.Error(codes.Internal, "failed"))
+	assert.Equal(t, `error while prefetching cache of CDN resource "cdn-resource-id": rpc error: code = Internal desc = failed`, err.Error())
+}
+
+func TestCDNCachePurgeValidate(t *testing.T) {
+	validate := func(raw map[string]interface{}) error {
+		diags := resourceYandexCDNCachePurge().Validate(terraform.NewResourceConfigRaw(raw))
+		if diags.HasError() {
+			return fmt.Errorf("%v", diags)
+		}
+		return nil
+	}
+
+	assert.NoError(t, validate(map[string]interface{}{"resource_id": "cdn-resource-id", "purge_all": true}))
+	assert.NoError(t, validate(map[string]interface{}{"resource_id": "cdn-resource-id", "paths": []interface{}{"/index.html"}}))
+	assert.ErrorContains(t, validate(map[string]interface{}{"resource_id": "cdn-resource-id", "purge_all": false}), "purge_all must be true if set")
+	assert.Error(t, validate(map[string]interface{}{"resource_id": "cdn-resource-id"}))
+}
+
+func TestAccCDNCachePurge_basic(t *testing.T) {
+	t.Parallel()
+
+	groupName := fmt.Sprintf("tf-test-cdn-purge-%s", acctest.RandString(10))
+	resourceCName := fmt.Sprintf("cdn-tf-test-%s.yandex.net", acctest.RandString(4))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCDNResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCDNCachePurge_basic(groupName, resourceCName, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("yandex_cdn_cache_purge.purge", "operation_id"),
+					resource.TestCheckResourceAttr("yandex_cdn_cache_purge.purge", "paths.#", "2"),
+					resource.TestCheckResourceAttrSet("yandex_cdn_cache_purge.purge_all", "operation_id"),
+					resource.TestCheckResourceAttrSet("yandex_cdn_cache_prefetch.prefetch", "operation_id"),
+				),
+			},
+			{
+				Config: testAccCDNCachePurge_basic(groupName, resourceCName, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_cdn_cache_purge.purge", "triggers.version", "v2"),
+					resource.TestCheckResourceAttrSet("yandex_cdn_cache_purge.purge", "operation_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCDNCachePurge_basic(groupName, resourceCNAME, version string) string {
+	return testAccCDNResource_basicByID(groupName, resourceCNAME) + fmt.Sprintf(`
+resource "yandex_cdn_cache_purge" "purge" {
+	resource_id = yandex_cdn_resource.foobar_resource.id
+	paths       = ["/index.html", "/static/*"]
+
+	triggers = {
+		version = "%[1]s"
+	}
+}
+
+resource "yandex_cdn_cache_purge" "purge_all" {
+	resource_id = yandex_cdn_resource.foobar_resource.id
+	purge_all   = true
+}
+
+resource "yandex_cdn_cache_prefetch" "prefetch" {
+	resource_id = yandex_cdn_resource.foobar_resource.id
+	paths       = ["/index.html"]
+
+	triggers = {
+		version = "%[1]s"
+	}
+
+	depends_on = [yandex_cdn_cache_purge.purge]
+}
+`, version)
+}
@@ -19,7 +19,7 @@ const StandardImagesFolderID = "standard-images"
 
 func resourceYandexComputeImage() *schema.Resource {
 	return &schema.Resource{
-		Description: "Creates a virtual machine image resource for the Yandex Compute Cloud service from an existing tarball. For more information, see [the official documentation](https://yandex.cloud/docs/compute/concepts/image).\n\n~> One of `source_family`, `source_image`, `source_snapshot`, `source_disk` or `source_url` must be specified.\n",
+		Description: "Creates a virtual machine image resource for the Yandex Compute Cloud service from an existing tarball. For more information, see [the official documentation](https://yandex.cloud/docs/compute/concepts/image).\n\n~> One of `source_family`, `source_image`, `source_snapshot`, `source_disk`, `source_url` or `source_file` must be specified.\n",
 
 		Create: resourceYandexComputeImageCreate,
 		Read:   resourceYandexComputeImageRead,
@@ -35,6 +35,8 @@ func resourceYandexComputeImage() *schema.Resource {
 			Delete: schema.DefaultTimeout(yandexComputeImageDefaultTimeout),
 		},
 
+		CustomizeDiff: resourceYandexComputeImageCustomizeDiffSourceFile,
+
 		SchemaVersion: 0,
 
 		Schema: map[string]*schema.Schema{
@@ -103,7 +105,7 @@ func resourceYandexComputeImage() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_snapshot", "source_disk", "source_url", "source_image"},
+				ConflictsWith: []string{"source_snapshot", "source_disk", "source_url", "source_image", "source_file"},
 			},
 
 			"source_image": {
@@ -112,7 +114,7 @@ func resourceYandexComputeImage() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_snapshot", "source_disk", "source_url", "source_family"},
+				ConflictsWith: []string{"source_snapshot", "source_disk", "source_url", "source_family", "source_file"},
 			},
 
 			"source_snapshot": {
@@ -121,7 +123,7 @@ func resourceYandexComputeImage() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_image", "source_disk", "source_url", "source_family"},
+				ConflictsWith: []string{"source_image", "source_disk", "source_url", "source_family", "source_file"},
 			},
 
 			"source_disk": {
@@ -130,7 +132,7 @@ func resourceYandexComputeImage() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_image", "source_snapshot", "source_url", "source_family"},
+				ConflictsWith: []string{"source_image", "source_snapshot", "source_url", "source_family", "source_file"},
 			},
 
 			"source_url": {
@@ -139,7 +141,56 @@ func resourceYandexComputeImage() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_image", "source_snapshot", "source_disk", "source_family"},
+				ConflictsWith: []string{"source_image", "source_snapshot", "source_disk", "source_family", "source_file"},
+			},
+
+			"source_file": {
+				Type:          schema.TypeString,
+				Description:   "The path to a local image file to use as the source of the image. The file is uploaded to `staging_bucket`, and the staged object is deleted once the image is created. Changing the path or the contents of the file forces a new resource to be created. If the file of an existing image is removed, the image is kept.",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_image", "source_snapshot", "source_disk", "source_url", "source_family"},
+				RequiredWith:  []string{"staging_bucket"},
+			},
+
+			"staging_bucket": {
+				Type:         schema.TypeString,
+				Description:  "The name of an Object Storage bucket to stage `source_file` in. The image is created from a presigned URL of the staged object, so static access keys are required: either `access_key` and `secret_key`, or `storage_access_key` and `storage_secret_key` of the provider. IAM token authorization is not enough.",
+				Optional:     true,
+				RequiredWith: []string{"source_file"},
+			},
+
+			"access_key": {
+				Type:         schema.TypeString,
+				Description:  "The access key to upload `source_file` to `staging_bucket`. This value can also be provided as `storage_access_key` specified in provider config (explicitly or within `shared_credentials_file`) is used.",
+				Optional:     true,
+				RequiredWith: []string{"source_file", "secret_key"},
+			},
+
+			"secret_key": {
+				Type:         schema.TypeString,
+				Description:  "The secret key to upload `source_file` to `staging_bucket`. This value can also be provided as `storage_secret_key` specified in provider config (explicitly or within `shared_credentials_file`) is used.",
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"source_file", "access_key"},
+			},
+
+			"source_file_hash": {
+				Type:        schema.TypeString,
+				Description: "The SHA256 checksum of `source_file`.",
+				Computed:    true,
+			},
+
+			"source_file_size": {
+				Type:        schema.TypeInt,
+				Description: "The size of `source_file` in bytes at the time of upload.",
+				Computed:    true,
+			},
+
+			"source_file_modified_at": {
+				Type:        schema.TypeString,
+				Description: "The modification time of `source_file` at the time of upload. `source_file` is only hashed again when its size or modification time change.",
+				Computed:    true,
 			},
 
 			"product_ids": {
@@ -259,14 +310,22 @@ func resourceYandexComputeImageCreate(d *schema.ResourceData, meta interface{})
 		HardwareGeneration: hardwareGeneration,
 	}
 
-	err = prepareSourceForImage(&req, d, meta)
-	if err != nil {
-		return fmt.Errorf("Error while prepare request to create image: %s", err)
-	}
-
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
+	if _, ok := d.GetOk("source_file"); ok {
+		cleanup, err := prepareSourceFileForImage(ctx, &req, d, config)
+		if err != nil {
+			return fmt.Errorf("Error while staging source file of image: %s", err)
+		}
+		defer cleanup()
+	} else {
+		err = prepareSourceForImage(&req, d, meta)
+		if err != nil {
+			return fmt.Errorf("Error while prepare request to create image: %s", err)
+		}
+	}
+
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Image().Create(ctx, &req))
 	if err != nil {
 		return fmt.Errorf("Error while requesting API to create image: %s", err)
@@ -1259,6 +1259,41 @@ func resourceYandexComputeInstanceGroup() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+
+			"wait_for_rollout": {
+				Type: schema.TypeList,
+				Description: "Wait for the instances to be deployed after the instance group is updated, instead of returning as soon as the operation completes. " +
+					"The rollout is complete when the group has reached its target size and all instances are `RUNNING_ACTUAL`, " +
+					"i.e. have passed health checks and were opened for traffic in the attached load balancer target groups. " +
+					"If the rollout is not complete within `timeout`, the apply fails with the statuses of the instances that are not ready.",
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timeout": {
+							Type:         schema.TypeString,
+							Description:  "Maximum time to wait for the rollout. The default is `15m`.",
+							Optional:     true,
+							Default:      "15m",
+							ValidateFunc: validateParsableValue(parseDuration),
+						},
+						"check_interval": {
+							Type:         schema.TypeString,
+							Description:  "Interval between checks of the instance statuses. The default is `15s`.",
+							Optional:     true,
+							Default:      "15s",
+							ValidateFunc: validateParsableValue(parseDuration),
+						},
+						"on_create": {
+							Type: schema.TypeBool,
+							Description: "Also wait for the rollout after the instance group is created. " +
+								"If the rollout is not complete within `timeout`, the created group is marked as tainted and is replaced on the next apply. The default is `false`.",
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -1296,6 +1331,12 @@ func resourceYandexComputeInstanceGroupCreate(d *schema.ResourceData, meta inter
 
 	d.SetId(instanceGroup.Id)
 
+	if d.Get("wait_for_rollout.0.on_create").(bool) {
+		if err := waitInstanceGroupRollout(config, d); err != nil {
+			return err
+		}
+	}
+
 	return resourceYandexComputeInstanceGroupRead(d, meta)
 }
 
@@ -1429,6 +1470,10 @@ func resourceYandexComputeInstanceGroupUpdate(d *schema.ResourceData, meta inter
 		return err
 	}
 
+	if err := waitInstanceGroupRollout(config, d); err != nil {
+		return err
+	}
+
 	return resourceYandexComputeInstanceGroupRead(d, meta)
 }
 
@@ -0,0 +1,445 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/genproto/protobuf/field_mask"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+const yandexComputeSnapshotCopyDefaultTimeout = 60 * time.Minute
+
+// Labels with the lineage of the copy, set on the snapshot in addition to the user-defined labels.
+const (
+	snapshotCopySourceSnapshotLabel = "source-snapshot-id"
+	snapshotCopySourceFolderLabel   = "source-folder-id"
+	snapshotCopySourceDiskLabel     = "source-disk-id"
+)
+
+var snapshotCopyLineageLabels = []string{
+	snapshotCopySourceSnapshotLabel,
+	snapshotCopySourceFolderLabel,
+	snapshotCopySourceDiskLabel,
+}
+
+func resourceYandexComputeSnapshotCopy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Copies a snapshot to another folder, possibly in another cloud. The API can't copy snapshots directly, so the snapshot is restored to a temporary image and disk in the target folder, and a new snapshot is taken from the disk. The temporary image and disk are deleted afterwards. For more information, see [the official documentation](https://yandex.cloud/docs/compute/concepts/snapshot).\n\n~> The source lineage is stored in the `source-snapshot-id`, `source-folder-id` and `source-disk-id` labels of the copy, so these label keys can't be used in `labels`.\n",
+
+		Create: resourceYandexComputeSnapshotCopyCreate,
+		Read:   resourceYandexComputeSnapshotCopyRead,
+		Update: resourceYandexComputeSnapshotCopyUpdate,
+		Delete: resourceYandexComputeSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexComputeSnapshotCopyDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexComputeSnapshotDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexComputeSnapshotDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"source_snapshot_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the snapshot to copy.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["name"],
+				Optional:    true,
+				Default:     "",
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["description"],
+				Optional:    true,
+			},
+
+			"folder_id": {
+				Type:        schema.TypeString,
+				Description: "The folder to copy the snapshot to. If it is not provided, the default provider `folder-id` is used.",
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+			},
+
+			"zone": {
+				Type:        schema.TypeString,
+				Description: "The availability zone to create the temporary disk in. If it is not provided, the default provider `zone` is used.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+
+			"labels": {
+				Type:         schema.TypeMap,
+				Description:  common.ResourceDescriptions["labels"],
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Set:          schema.HashString,
+				ValidateFunc: validateSnapshotCopyLabels,
+			},
+
+			"source_folder_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the folder of the source snapshot.",
+				Computed:    true,
+			},
+
+			"source_disk_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the disk the source snapshot was created from.",
+				Computed:    true,
+			},
+
+			"disk_size": {
+				Type:        schema.TypeInt,
+				Description: "Size of the disk when the snapshot was created, specified in GB.",
+				Computed:    true,
+			},
+
+			"storage_size": {
+				Type:        schema.TypeInt,
+				Description: "Size of the snapshot, specified in GB.",
+				Computed:    true,
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["created_at"],
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func validateSnapshotCopyLabels(v interface{}, k string) ([]string, []error) {
+	var errs []error
+	labels := v.(map[string]interface{})
+	for _, key := range snapshotCopyLineageLabels {
+		if _, ok := labels[key]; ok {
+			errs = append(errs, fmt.Errorf("%s: label %q is reserved for the lineage of the copy", k, key))
+		}
+	}
+	return nil, errs
+}
+
+func resourceYandexComputeSnapshotCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	folderID, err := getFolderID(d, config)
+	if err != nil {
+		return fmt.Errorf("Error getting folder ID while copying snapshot: %s", err)
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return fmt.Errorf("Error getting zone while copying snapshot: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	source, err := config.sdk.Compute().Snapshot().Get(ctx, &compute.GetSnapshotRequest{
+		SnapshotId: d.Get("source_snapshot_id").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("Error while getting source snapshot %q: %s", d.Get("source_snapshot_id").(string), err)
+	}
+
+	labels, err := snapshotCopyLabels(d, source.Id, source.FolderId, source.SourceDiskId)
+	if err != nil {
+		return fmt.Errorf("Error expanding labels while copying snapshot: %s", err)
+	}
+
+	log.Printf("[INFO] Copying Snapshot %q to folder %q (1/3): creating temporary image", source.Id, folderID)
+	imageID, err := createSnapshotCopyImage(ctx, config, folderID, source)
+	if imageID != "" {
+		defer deleteSnapshotCopyImage(config, imageID)
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Copying Snapshot %q to folder %q (2/3): creating temporary disk", source.Id, folderID)
+	diskID, err := createSnapshotCopyDisk(ctx, config, folderID, zone, imageID, source)
+	if diskID != "" {
+		defer deleteSnapshotCopyDisk(config, diskID)
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Copying Snapshot %q to folder %q (3/3): creating snapshot", source.Id, folderID)
+	req := compute.CreateSnapshotRequest{
+		FolderId:    folderID,
+		DiskId:      diskID,
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Labels:      labels,
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.Compute().Snapshot().Create(ctx, &req))
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to create snapshot: %s", err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return fmt.Errorf("Error while get snapshot create operation metadata: %s", err)
+	}
+
+	md, ok := protoMetadata.(*compute.CreateSnapshotMetadata)
+	if !ok {
+		return fmt.Errorf("could not get Snapshot ID from create operation metadata")
+	}
+
+	d.SetId(md.SnapshotId)
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error while waiting operation to create snapshot: %s", err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("Snapshot creation failed: %s", err)
+	}
+
+	log.Printf("[INFO] Finished copying Snapshot %q to %q", source.Id, d.Id())
+
+	return resourceYandexComputeSnapshotCopyRead(d, meta)
+}
+
+func createSnapshotCopyImage(ctx context.Context, config *Config, folderID string, source *compute.Snapshot) (string, error) {
+	op, err := config.sdk.WrapOperation(config.sdk.Compute().Image().Create(ctx, &compute.CreateImageRequest{
+		FolderId:    folderID,
+		Description: fmt.Sprintf("Temporary image to copy snapshot %s", source.Id),
+		Source: &compute.CreateImageRequest_SnapshotId{
+			SnapshotId: source.Id,
+		},
+	}))
+	if err != nil {
+		return "", fmt.Errorf("Error while requesting API to create temporary image: %s", err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return "", fmt.Errorf("Error while get image create operation metadata: %s", err)
+	}
+
+	md, ok := protoMetadata.(*compute.CreateImageMetadata)
+	if !ok {
+		return "", fmt.Errorf("could not get Image ID from create operation metadata")
+	}
+
+	if err := op.Wait(ctx); err != nil {
+		return md.ImageId, fmt.Errorf("Error while waiting operation to create temporary image: %s", err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return md.ImageId, fmt.Errorf("Temporary image creation failed: %s", err)
+	}
+
+	return md.ImageId, nil
+}
+
+func createSnapshotCopyDisk(ctx context.Context, config *Config, folderID, zone, imageID string, source *compute.Snapshot) (string, error) {
+	op, err := config.sdk.WrapOperation(config.sdk.Compute().Disk().Create(ctx, &compute.CreateDiskRequest{
+		FolderId:    folderID,
+		Description: fmt.Sprintf("Temporary disk to copy snapshot %s", source.Id),
+		ZoneId:      zone,
+		Size:        source.DiskSize,
+		Source: &compute.CreateDiskRequest_ImageId{
+			ImageId: imageID,
+		},
+	}))
+	if err != nil {
+		return "", fmt.Errorf("Error while requesting API to create temporary disk: %s", err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return "", fmt.Errorf("Error while get disk create operation metadata: %s", err)
+	}
+
+	md, ok := protoMetadata.(*compute.CreateDiskMetadata)
+	if !ok {
+		return "", fmt.Errorf("could not get Disk ID from create operation metadata")
+	}
+
+	if err := op.Wait(ctx); err != nil {
+		return md.DiskId, fmt.Errorf("Error while waiting operation to create temporary disk: %s", err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return md.DiskId, fmt.Errorf("Temporary disk creation failed: %s", err)
+	}
+
+	return md.DiskId, nil
+}
+
+// deleteSnapshotCopyImage and deleteSnapshotCopyDisk only log failures, since the copy itself is already done
+// or failed with a more relevant error. They use a fresh context, as the create context may have expired.
+func deleteSnapshotCopyImage(config *Config, imageID string) {
+	ctx, cancel := context.WithTimeout(config.Context(), yandexComputeImageDefaultTimeout)
+	defer cancel()
+
+	op, err := config.sdk.WrapOperation(config.sdk.Compute().Image().Delete(ctx, &compute.DeleteImageRequest{
+		ImageId: imageID,
+	}))
+	if err == nil {
+		err = op.Wait(ctx)
+	}
+	if err != nil {
+		log.Printf("[WARN] Failed to delete temporary image %q: %s", imageID, err)
+	}
+}
+
+func deleteSnapshotCopyDisk(config *Config, diskID string) {
+	ctx, cancel := context.WithTimeout(config.Context(), yandexComputeDiskDefaultTimeout)
+	defer cancel()
+
+	op, err := config.sdk.WrapOperation(config.sdk.Compute().Disk().Delete(ctx, &compute.DeleteDiskRequest{
+		DiskId: diskID,
+	}))
+	if err == nil {
+		err = op.Wait(ctx)
+	}
+	if err != nil {
+		log.Printf("[WARN] Failed to delete temporary disk %q: %s", diskID, err)
+	}
+}
+
+// snapshotCopyLabels returns the user-defined labels with the lineage of the copy.
+func snapshotCopyLabels(d *schema.ResourceData, sourceSnapshotID, sourceFolderID, sourceDiskID string) (map[string]string, error) {
+	labels, err := expandLabels(d.Get("labels"))
+	if err != nil {
+		return nil, err
+	}
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+
+	lineage := map[string]string{
+		snapshotCopySourceSnapshotLabel: sourceSnapshotID,
+		snapshotCopySourceFolderLabel:   sourceFolderID,
+		snapshotCopySourceDiskLabel:     sourceDiskID,
+	}
+	for k, v := range lineage {
+		if v != "" {
+			labels[k] = v
+		}
+	}
+	return labels, nil
+}
+
+func resourceYandexComputeSnapshotCopyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	snapshot, err := config.sdk.Compute().Snapshot().Get(config.Context(), &compute.GetSnapshotRequest{
+		SnapshotId: d.Id(),
+	})
+
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Snapshot %q", d.Get("name").(string)))
+	}
+
+	labels := make(map[string]string, len(snapshot.Labels))
+	for k, v := range snapshot.Labels {
+		labels[k] = v
+	}
+	for _, k := range snapshotCopyLineageLabels {
+		delete(labels, k)
+	}
+
+	d.Set("created_at", getTimestamp(snapshot.CreatedAt))
+	d.Set("name", snapshot.Name)
+	d.Set("folder_id", snapshot.FolderId)
+	d.Set("description", snapshot.Description)
+	d.Set("disk_size", toGigabytes(snapshot.DiskSize))
+	d.Set("storage_size", toGigabytes(snapshot.StorageSize))
+	d.Set("source_folder_id", snapshot.Labels[snapshotCopySourceFolderLabel])
+	d.Set("source_disk_id", snapshot.Labels[snapshotCopySourceDiskLabel])
+	if v, ok := snapshot.Labels[snapshotCopySourceSnapshotLabel]; ok {
+		d.Set("source_snapshot_id", v)
+	}
+
+	return d.Set("labels", labels)
+}
+
+func resourceYandexComputeSnapshotCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(true)
+
+	labelPropName := "labels"
+	if d.HasChange(labelPropName) {
+		labelsProp, err := snapshotCopyLabels(d, d.Get("source_snapshot_id").(string), d.Get("source_folder_id").(string), d.Get("source_disk_id").(string))
+		if err != nil {
+			return err
+		}
+
+		req := &compute.UpdateSnapshotRequest{
+			SnapshotId: d.Id(),
+			Labels:     labelsProp,
+			UpdateMask: &field_mask.FieldMask{
+				Paths: []string{labelPropName},
+			},
+		}
+
+		err = makeSnapshotUpdateRequest(req, d, meta)
+		if err != nil {
+			return err
+		}
+
+	}
+
+	namePropName := "name"
+	if d.HasChange(namePropName) {
+		req := &compute.UpdateSnapshotRequest{
+			SnapshotId: d.Id(),
+			Name:       d.Get(namePropName).(string),
+			UpdateMask: &field_mask.FieldMask{
+				Paths: []string{namePropName},
+			},
+		}
+
+		err := makeSnapshotUpdateRequest(req, d, meta)
+		if err != nil {
+			return err
+		}
+
+	}
+
+	descPropName := "description"
+	if d.HasChange(descPropName) {
+		req := &compute.UpdateSnapshotRequest{
+			SnapshotId:  d.Id(),
+			Description: d.Get(descPropName).(string),
+			UpdateMask: &field_mask.FieldMask{
+				Paths: []string{descPropName},
+			},
+		}
+
+		err := makeSnapshotUpdateRequest(req, d, meta)
+		if err != nil {
+			return err
+		}
+
+	}
+
+	d.Partial(false)
+
+	return resourceYandexComputeSnapshotCopyRead(d, meta)
+}
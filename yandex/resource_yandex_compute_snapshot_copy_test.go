@@ -0,0 +1,97 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccComputeSnapshotCopy_basic(t *testing.T) {
+	t.Parallel()
+
+	diskName := acctest.RandomWithPrefix("tf-disk")
+	snapshotName := acctest.RandomWithPrefix("tf-snap")
+	copyName := acctest.RandomWithPrefix("tf-snap-copy")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckComputeDiskDestroy,
+			testAccCheckComputeSnapshotDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeSnapshotCopyConfig(diskName, snapshotName, copyName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_compute_snapshot_copy.copy", "name", copyName),
+					resource.TestCheckResourceAttrPair("yandex_compute_snapshot_copy.copy", "source_snapshot_id",
+						"yandex_compute_snapshot.foobar", "id"),
+					resource.TestCheckResourceAttrPair("yandex_compute_snapshot_copy.copy", "source_disk_id",
+						"yandex_compute_disk.foobar", "id"),
+					resource.TestCheckResourceAttrPair("yandex_compute_snapshot_copy.copy", "source_folder_id",
+						"yandex_compute_snapshot.foobar", "folder_id"),
+					resource.TestCheckResourceAttr("yandex_compute_snapshot_copy.copy", "labels.%", "1"),
+					resource.TestCheckResourceAttr("yandex_compute_snapshot_copy.copy", "labels.purpose", "first"),
+					resource.TestCheckResourceAttr("yandex_compute_snapshot_copy.copy", "disk_size", "4"),
+					testAccCheckCreatedAtAttr("yandex_compute_snapshot_copy.copy"),
+				),
+			},
+			{
+				Config: testAccComputeSnapshotCopyConfig(diskName, snapshotName, copyName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_compute_snapshot_copy.copy", "labels.purpose", "second"),
+					resource.TestCheckResourceAttrPair("yandex_compute_snapshot_copy.copy", "source_snapshot_id",
+						"yandex_compute_snapshot.foobar", "id"),
+				),
+			},
+			{
+				ResourceName:      "yandex_compute_snapshot_copy.copy",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"zone",
+				},
+			},
+		},
+	})
+}
+
+func TestValidateSnapshotCopyLabels(t *testing.T) {
+	_, errs := validateSnapshotCopyLabels(map[string]interface{}{"env": "dr"}, "labels")
+	assert.Empty(t, errs)
+
+	_, errs = validateSnapshotCopyLabels(map[string]interface{}{"env": "dr", "source-snapshot-id": "fd8abc"}, "labels")
+	assert.Len(t, errs, 1)
+}
+
+func testAccComputeSnapshotCopyConfig(diskName, snapshotName, copyName, purpose string) string {
+	return fmt.Sprintf(`
+data "yandex_compute_image" "ubuntu" {
+  family = "ubuntu-1804-lts"
+}
+
+resource "yandex_compute_disk" "foobar" {
+  name     = "%s"
+  image_id = "${data.yandex_compute_image.ubuntu.id}"
+  size     = 4
+}
+
+resource "yandex_compute_snapshot" "foobar" {
+  name           = "%s"
+  source_disk_id = "${yandex_compute_disk.foobar.id}"
+}
+
+resource "yandex_compute_snapshot_copy" "copy" {
+  name               = "%s"
+  source_snapshot_id = "${yandex_compute_snapshot.foobar.id}"
+
+  labels = {
+    purpose = "%s"
+  }
+}
+`, diskName, snapshotName, copyName, purpose)
+}
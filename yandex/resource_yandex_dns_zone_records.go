@@ -0,0 +1,411 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/zonefile"
+)
+
+// dnsZoneRecordsBatchSize limits the number of record sets changed by a single UpsertRecordSets request.
+const dnsZoneRecordsBatchSize = 100
+
+func resourceYandexDnsZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages all records of a DNS Zone from a zone file in the [RFC 1035](https://www.rfc-editor.org/rfc/rfc1035#section-5) format.\n\n" +
+			"The resource is authoritative: records of the zone that are not in the zone file are deleted. " +
+			"SOA and NS records of the zone apex are maintained by Cloud DNS, so they are ignored.\n\n" +
+			"~> Don't use `yandex_dns_recordset` for the zone managed by this resource.\n",
+		CreateContext: resourceYandexDnsZoneRecordsCreate,
+		ReadContext:   resourceYandexDnsZoneRecordsRead,
+		UpdateContext: resourceYandexDnsZoneRecordsUpdate,
+		DeleteContext: resourceYandexDnsZoneRecordsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexDnsZoneRecordsImportState,
+		},
+
+		CustomizeDiff: resourceYandexDnsZoneRecordsCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexDnsDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexDnsDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexDnsDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the zone to manage records of.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"zone_file": {
+				Type:             schema.TypeString,
+				Description:      "Zone file with the records of the zone. `$ORIGIN` and `$TTL` directives, comments, quoted strings and multi-line records in parentheses are supported.",
+				Required:         true,
+				DiffSuppressFunc: dnsZoneFileDiffSuppress,
+			},
+
+			"origin": {
+				Type:        schema.TypeString,
+				Description: "The origin of relative names in `zone_file`, unless it is changed by `$ORIGIN`. It is the zone name.",
+				Computed:    true,
+			},
+
+			"record_sets": {
+				Type:        schema.TypeList,
+				Description: "Record sets of the zone managed by the resource.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The DNS name of the record set.",
+							Computed:    true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Description: "The DNS record set type.",
+							Computed:    true,
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Description: "The time-to-live of the record set (seconds).",
+							Computed:    true,
+						},
+						"data": {
+							Type:        schema.TypeList,
+							Description: "The string data for the records in the record set.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceYandexDnsZoneRecordsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	zoneID := d.Get("zone_id").(string)
+	if err := applyDnsZoneRecords(ctx, config, d, zoneID); err != nil {
+		return diag.Errorf("Error while creating records of DnsZone %q: %s", zoneID, err)
+	}
+	d.SetId(zoneID)
+
+	return resourceYandexDnsZoneRecordsRead(ctx, d, meta)
+}
+
+func resourceYandexDnsZoneRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diag.FromErr(readDnsZoneRecords(ctx, meta.(*Config), d))
+}
+
+func readDnsZoneRecords(ctx context.Context, config *Config, d *schema.ResourceData) error {
+	sdk := getSDK(config)
+
+	dnsZone, err := sdk.DNS().DnsZone().Get(ctx, &dns.GetDnsZoneRequest{
+		DnsZoneId: d.Id(),
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DnsZone %q", d.Id()))
+	}
+
+	d.Set("origin", dnsZone.Zone)
+
+	sets, err := listDnsZoneRecordSets(ctx, config, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error while reading records of DnsZone %q: %s", d.Id(), err)
+	}
+
+	d.Set("zone_id", d.Id())
+	return d.Set("record_sets", flattenDnsZoneRecordSets(managedDnsZoneRecordSets(dnsZone.Zone, sets)))
+}
+
+func resourceYandexDnsZoneRecordsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	if err := applyDnsZoneRecords(ctx, config, d, d.Id()); err != nil {
+		return diag.Errorf("Error while updating records of DnsZone %q: %s", d.Id(), err)
+	}
+
+	return resourceYandexDnsZoneRecordsRead(ctx, d, meta)
+}
+
+func resourceYandexDnsZoneRecordsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sdk := getSDK(config)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	dnsZone, err := sdk.DNS().DnsZone().Get(ctx, &dns.GetDnsZoneRequest{
+		DnsZoneId: d.Id(),
+	})
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("DnsZone %q", d.Id())))
+	}
+
+	current, err := listDnsZoneRecordSets(ctx, config, d.Id())
+	if err != nil {
+		return diag.Errorf("Error while reading records of DnsZone %q: %s", d.Id(), err)
+	}
+
+	if err := upsertDnsZoneRecordSets(ctx, config, d.Id(), managedDnsZoneRecordSets(dnsZone.Zone, current), nil); err != nil {
+		return diag.Errorf("Error while deleting records of DnsZone %q: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Finished deleting records of DnsZone %q", d.Id())
+	return nil
+}
+
+func resourceYandexDnsZoneRecordsImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	zoneID := d.Id()
+	if err := readDnsZoneRecords(ctx, meta.(*Config), d); err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("DnsZone %q not found", zoneID)
+	}
+
+	sets, err := expandDnsZoneRecordSets(d.Get("record_sets"))
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("zone_file", zonefile.Render(d.Get("origin").(string), sets)); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceYandexDnsZoneRecordsCustomizeDiff plans record_sets from the zone file,
+// so that the records changed outside of Terraform are updated as well.
+func resourceYandexDnsZoneRecordsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.HasChange("zone_id") {
+		if err := diff.SetNewComputed("origin"); err != nil {
+			return err
+		}
+		return diff.SetNewComputed("record_sets")
+	}
+
+	origin := diff.Get("origin").(string)
+	if origin == "" || !diff.NewValueKnown("zone_file") {
+		return diff.SetNewComputed("record_sets")
+	}
+
+	desired, err := zonefile.Parse(diff.Get("zone_file").(string), origin)
+	if err != nil {
+		return fmt.Errorf("Error while parsing zone_file: %s", err)
+	}
+	desired = managedDnsZoneRecordSets(origin, desired)
+
+	current, err := expandDnsZoneRecordSets(diff.Get("record_sets"))
+	if err != nil {
+		return err
+	}
+
+	if !equalDnsZoneRecordSets(current, desired) {
+		return diff.SetNew("record_sets", flattenDnsZoneRecordSets(desired))
+	}
+	return nil
+}
+
+// dnsZoneFileDiffSuppress suppresses the changes of the zone file that don't change its records, e.g. formatting.
+func dnsZoneFileDiffSuppress(_, old, new string, d *schema.ResourceData) bool {
+	origin := d.Get("origin").(string)
+	if origin == "" || old == "" {
+		return false
+	}
+	oldSets, err := zonefile.Parse(old, origin)
+	if err != nil {
+		return false
+	}
+	newSets, err := zonefile.Parse(new, origin)
+	if err != nil {
+		return false
+	}
+	return equalDnsZoneRecordSets(oldSets, newSets)
+}
+
+func applyDnsZoneRecords(ctx context.Context, config *Config, d *schema.ResourceData, zoneID string) error {
+	sdk := getSDK(config)
+
+	dnsZone, err := sdk.DNS().DnsZone().Get(ctx, &dns.GetDnsZoneRequest{
+		DnsZoneId: zoneID,
+	})
+	if err != nil {
+		return err
+	}
+
+	d.Set("origin", dnsZone.Zone)
+
+	desired, err := zonefile.Parse(d.Get("zone_file").(string), dnsZone.Zone)
+	if err != nil {
+		return fmt.Errorf("failed to parse zone_file: %s", err)
+	}
+
+	current, err := listDnsZoneRecordSets(ctx, config, zoneID)
+	if err != nil {
+		return err
+	}
+
+	return upsertDnsZoneRecordSets(ctx, config, zoneID,
+		managedDnsZoneRecordSets(dnsZone.Zone, current),
+		managedDnsZoneRecordSets(dnsZone.Zone, desired),
+	)
+}
+
+func listDnsZoneRecordSets(ctx context.Context, config *Config, zoneID string) ([]zonefile.RecordSet, error) {
+	it := getSDK(config).DNS().DnsZone().DnsZoneRecordSetsIterator(ctx, &dns.ListDnsZoneRecordSetsRequest{
+		DnsZoneId: zoneID,
+	})
+
+	var sets []zonefile.RecordSet
+	for it.Next() {
+		rs := it.Value()
+		sets = append(sets, zonefile.Normalize(zonefile.RecordSet{
+			Name: rs.Name,
+			Type: rs.Type,
+			TTL:  rs.Ttl,
+			Data: rs.Data,
+		}))
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	zonefile.Sort(sets)
+	return sets, nil
+}
+
+// upsertDnsZoneRecordSets changes the current record sets of the zone into the desired ones in batches.
+// Deletions go first, so that e.g. a CNAME can replace other records of the same name.
+func upsertDnsZoneRecordSets(ctx context.Context, config *Config, zoneID string, current, desired []zonefile.RecordSet) error {
+	desiredByKey := make(map[string]zonefile.RecordSet, len(desired))
+	for _, rs := range desired {
+		desiredByKey[rs.Key()] = rs
+	}
+	currentByKey := make(map[string]zonefile.RecordSet, len(current))
+	for _, rs := range current {
+		currentByKey[rs.Key()] = rs
+	}
+
+	var deletions, replacements []*dns.RecordSet
+	for _, rs := range current {
+		if _, ok := desiredByKey[rs.Key()]; !ok {
+			deletions = append(deletions, expandDnsRecordSet(rs))
+		}
+	}
+	for _, rs := range desired {
+		if cur, ok := currentByKey[rs.Key()]; !ok || !cur.Equal(rs) {
+			replacements = append(replacements, expandDnsRecordSet(rs))
+		}
+	}
+
+	sdk := getSDK(config)
+	upsert := func(req *dns.UpsertRecordSetsRequest) error {
+		log.Printf("[DEBUG] Upserting records of DnsZone %q: %d deletions, %d replacements", zoneID, len(req.Deletions), len(req.Replacements))
+		op, err := sdk.WrapOperation(sdk.DNS().DnsZone().UpsertRecordSets(ctx, req))
+		if err != nil {
+			return err
+		}
+		return op.Wait(ctx)
+	}
+
+	for start := 0; start < len(deletions); start += dnsZoneRecordsBatchSize {
+		end := min(start+dnsZoneRecordsBatchSize, len(deletions))
+		if err := upsert(&dns.UpsertRecordSetsRequest{DnsZoneId: zoneID, Deletions: deletions[start:end]}); err != nil {
+			return err
+		}
+	}
+	for start := 0; start < len(replacements); start += dnsZoneRecordsBatchSize {
+		end := min(start+dnsZoneRecordsBatchSize, len(replacements))
+		if err := upsert(&dns.UpsertRecordSetsRequest{DnsZoneId: zoneID, Replacements: replacements[start:end]}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func managedDnsZoneRecordSets(origin string, sets []zonefile.RecordSet) []zonefile.RecordSet {
+	var managed []zonefile.RecordSet
+	for _, rs := range sets {
+		if !zonefile.IsManagedByZone(origin, rs) {
+			managed = append(managed, rs)
+		}
+	}
+	return managed
+}
+
+func equalDnsZoneRecordSets(a, b []zonefile.RecordSet) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	byKey := make(map[string]zonefile.RecordSet, len(a))
+	for _, rs := range a {
+		byKey[rs.Key()] = rs
+	}
+	for _, rs := range b {
+		if other, ok := byKey[rs.Key()]; !ok || !other.Equal(rs) {
+			return false
+		}
+	}
+	return true
+}
+
+func expandDnsRecordSet(rs zonefile.RecordSet) *dns.RecordSet {
+	return &dns.RecordSet{
+		Name: rs.Name,
+		Type: rs.Type,
+		Ttl:  rs.TTL,
+		Data: rs.Data,
+	}
+}
+
+func flattenDnsZoneRecordSets(sets []zonefile.RecordSet) []interface{} {
+	result := make([]interface{}, 0, len(sets))
+	for _, rs := range sets {
+		result = append(result, map[string]interface{}{
+			"name": rs.Name,
+			"type": rs.Type,
+			"ttl":  int(rs.TTL),
+			"data": convertStringArrToInterface(rs.Data),
+		})
+	}
+	return result
+}
+
+func expandDnsZoneRecordSets(v interface{}) ([]zonefile.RecordSet, error) {
+	var sets []zonefile.RecordSet
+	for _, raw := range v.([]interface{}) {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected record set value %v", raw)
+		}
+		var data []string
+		for _, d := range m["data"].([]interface{}) {
+			data = append(data, d.(string))
+		}
+		sets = append(sets, zonefile.RecordSet{
+			Name: m["name"].(string),
+			Type: m["type"].(string),
+			TTL:  int64(m["ttl"].(int)),
+			Data: data,
+		})
+	}
+	return sets, nil
+}
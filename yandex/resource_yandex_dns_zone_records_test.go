@@ -0,0 +1,78 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDNSZoneRecords_basic(t *testing.T) {
+	t.Parallel()
+
+	zoneName := acctest.RandomWithPrefix("tf-dns-zone")
+	fqdn := acctest.RandomWithPrefix("tf-test") + ".dnstest.test."
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPCAddressDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSZoneRecords(zoneName, fqdn, "192.168.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.records", "origin", fqdn),
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.records", "record_sets.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("yandex_dns_zone_records.records", "record_sets.*", map[string]string{
+						"name":   "srv." + fqdn,
+						"type":   "A",
+						"ttl":    "300",
+						"data.0": "192.168.0.1",
+					}),
+					resource.TestCheckResourceAttrSet("data.yandex_dns_zone_export.export", "zone_file"),
+				),
+			},
+			{
+				Config: testAccDNSZoneRecords(zoneName, fqdn, "192.168.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("yandex_dns_zone_records.records", "record_sets.*", map[string]string{
+						"name":   "srv." + fqdn,
+						"type":   "A",
+						"data.0": "192.168.0.2",
+					}),
+				),
+			},
+			{
+				ResourceName:            "yandex_dns_zone_records.records",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zone_file"},
+			},
+		},
+	})
+}
+
+func testAccDNSZoneRecords(zoneName, fqdn, address string) string {
+	return fmt.Sprintf(`
+resource "yandex_dns_zone" "zone1" {
+  name   = "%s"
+  zone   = "%s"
+  public = true
+}
+
+resource "yandex_dns_zone_records" "records" {
+  zone_id   = yandex_dns_zone.zone1.id
+  zone_file = <<-EOT
+    $TTL 300
+    srv   IN A     %s
+    www   IN CNAME srv
+    @     IN TXT   "v=spf1 -all"
+  EOT
+}
+
+data "yandex_dns_zone_export" "export" {
+  dns_zone_id = yandex_dns_zone_records.records.zone_id
+}
+`, zoneName, fqdn, address)
+}
@@ -4,9 +4,13 @@ import (
 	"archive/zip"
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -20,14 +24,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/logging/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/functions/v1"
-	ycsdk "github.com/yandex-cloud/go-sdk"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/storage/s3"
 	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/grpc/codes"
 )
 
 const yandexFunctionDefaultTimeout = 10 * time.Minute
 const versionCreateSourceContentMaxBytes = 3670016
+const functionStagingPrefix = "terraform-function-staging"
+
+// zipModifiedTime is the modification time of zipped files, fixed to keep archives deterministic.
+var zipModifiedTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
 
 func resourceYandexFunction() *schema.Resource {
 	return &schema.Resource{
@@ -66,8 +74,14 @@ func resourceYandexFunction() *schema.Resource {
 
 			"user_hash": {
 				Type:        schema.TypeString,
-				Description: "User-defined string for current function version. User must change this string any times when function changed. Function will be updated when hash is changed.",
-				Required:    true,
+				Description: "User-defined string for current function version. User must change this string any times when function changed. Function will be updated when hash is changed. Not needed when `content` is used, since `content_hash` tracks changes of the content.",
+				Optional:    true,
+			},
+
+			"content_hash": {
+				Type:        schema.TypeString,
+				Description: "SHA256 hash of the zip archive built from `content`. Function version is created again when hash is changed.",
+				Computed:    true,
 			},
 
 			"runtime": {
@@ -177,9 +191,20 @@ func resourceYandexFunction() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"zip_filename": {
 							Type:        schema.TypeString,
-							Description: "Filename to zip archive for the version.",
+							Description: "Filename to zip archive for the version. If it is a directory, it is zipped with fixed modification times, so that the archive only changes with its files.",
 							Required:    true,
 						},
+						"exclude": {
+							Type:        schema.TypeList,
+							Description: "Glob patterns of files and directories to exclude from the zip archive, matched against both the path relative to `zip_filename` and the base name. Ignored if `zip_filename` is already a zip archive.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"staging_bucket": {
+							Type:        schema.TypeString,
+							Description: "Name of the Object Storage bucket to upload the zip archive to, if it exceeds the maximum content size of the API. The uploaded object is deleted once the version is created. Requires storage access of the provider.",
+							Optional:    true,
+						},
 					},
 				},
 			},
@@ -535,7 +560,7 @@ func resourceYandexFunctionCreate(ctx context.Context, d *schema.ResourceData, m
 	if versionReq != nil {
 		versionReq.FunctionId = md.FunctionId
 		diags = resourceYandexFunctionDiagsFromCreateVersionError(
-			resourceYandexFunctionCreateVersion(ctx, config.sdk, versionReq),
+			resourceYandexFunctionCreateVersion(ctx, config, d, versionReq),
 		)
 	}
 
@@ -557,9 +582,17 @@ func resourceYandexFunctionDiagsFromCreateVersionError(err error) diag.Diagnosti
 
 func resourceYandexFunctionCreateVersion(
 	ctx context.Context,
-	sdk *ycsdk.SDK,
+	config *Config,
+	d *schema.ResourceData,
 	req *functions.CreateFunctionVersionRequest,
 ) error {
+	cleanup, err := stageFunctionContent(ctx, config, d.Get("content.0.staging_bucket").(string), req)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	sdk := config.sdk
 	op, err := sdk.WrapOperation(sdk.Serverless().Functions().Function().CreateVersion(ctx, req))
 	if err != nil {
 		return err
@@ -567,6 +600,55 @@ func resourceYandexFunctionCreateVersion(
 	return op.Wait(ctx)
 }
 
+// stageFunctionContent uploads the content of the version to stagingBucket if it exceeds the maximum content size,
+// and replaces it with the uploaded package. The returned function deletes the uploaded object.
+func stageFunctionContent(
+	ctx context.Context,
+	config *Config,
+	stagingBucket string,
+	req *functions.CreateFunctionVersionRequest,
+) (func(), error) {
+	content := req.GetContent()
+	if len(content) <= versionCreateSourceContentMaxBytes {
+		return func() {}, nil
+	}
+
+	s3Client, err := getS3ClientByKeys(ctx, "", "", config)
+	if err != nil {
+		return nil, fmt.Errorf("error getting storage client to stage content of Yandex Cloud Function: %s", err)
+	}
+
+	hash := functionContentHash(content)
+	key := fmt.Sprintf("%s/%s/%s.zip", functionStagingPrefix, req.FunctionId, hash)
+	log.Printf("[DEBUG] Staging content of Yandex Cloud Function %q to %s/%s", req.FunctionId, stagingBucket, key)
+	_, err = s3Client.CreateObject(ctx, s3.CreationData{
+		Bucket: stagingBucket,
+		Key:    key,
+		Source: &s3.Source{
+			Type:  s3.SourceTypeContent,
+			Value: string(content),
+		},
+		ContentType: "application/zip",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error staging content of Yandex Cloud Function: %s", err)
+	}
+
+	req.PackageSource = &functions.CreateFunctionVersionRequest_Package{
+		Package: &functions.Package{
+			BucketName: stagingBucket,
+			ObjectName: key,
+			Sha256:     hash,
+		},
+	}
+
+	return func() {
+		if err := s3Client.DeleteObject(ctx, stagingBucket, key); err != nil {
+			log.Printf("[WARN] Failed to delete staged content of Yandex Cloud Function %s/%s: %s", stagingBucket, key, err)
+		}
+	}, nil
+}
+
 func resourceYandexFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
@@ -594,7 +676,7 @@ func resourceYandexFunctionUpdate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	lastVersionPaths := []string{
-		"user_hash", "runtime", "entrypoint", "memory", "execution_timeout", "service_account_id",
+		"user_hash", "content_hash", "runtime", "entrypoint", "memory", "execution_timeout", "service_account_id",
 		"environment", "tags", "package", "content", "secrets", "connectivity", "async_invocation",
 		"storage_mounts", "mounts", "log_options", "tmpfs_size", "concurrency", "metadata_options",
 	}
@@ -604,6 +686,10 @@ func resourceYandexFunctionUpdate(ctx context.Context, d *schema.ResourceData, m
 			versionPartialPaths = append(versionPartialPaths, p)
 		}
 	}
+	if oldHash, _ := d.GetChange("content_hash"); oldHash.(string) == "" && len(versionPartialPaths) == 1 && versionPartialPaths[0] == "content_hash" {
+		// content_hash was not tracked by previous versions of the provider, don't create the same version again
+		versionPartialPaths = nil
+	}
 
 	var versionReq *functions.CreateFunctionVersionRequest
 	if len(versionPartialPaths) != 0 {
@@ -634,7 +720,7 @@ func resourceYandexFunctionUpdate(ctx context.Context, d *schema.ResourceData, m
 	if versionReq != nil {
 		versionReq.FunctionId = d.Id()
 		diags = resourceYandexFunctionDiagsFromCreateVersionError(
-			resourceYandexFunctionCreateVersion(ctx, config.sdk, versionReq),
+			resourceYandexFunctionCreateVersion(ctx, config, d, versionReq),
 		)
 	}
 	d.Partial(false)
@@ -700,6 +786,10 @@ func resourceYandexFunctionDelete(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceYandexFunctionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if err := resourceYandexFunctionCustomizeDiffContentHash(diff); err != nil {
+		return err
+	}
+
 	if diff.HasChange("mounts") || diff.HasChange("storage_mounts") {
 		mounts := diff.Get("mounts").([]interface{})
 		storageMounts := diff.Get("storage_mounts").([]interface{})
@@ -714,6 +804,37 @@ func resourceYandexFunctionCustomizeDiff(_ context.Context, diff *schema.Resourc
 	return nil
 }
 
+// resourceYandexFunctionCustomizeDiffContentHash plans content_hash, so that a new version is created when the content is changed.
+func resourceYandexFunctionCustomizeDiffContentHash(diff *schema.ResourceDiff) error {
+	if _, ok := diff.GetOk("content"); !ok {
+		if diff.Get("content_hash").(string) != "" {
+			return diff.SetNew("content_hash", "")
+		}
+		return nil
+	}
+
+	if !diff.NewValueKnown("content.0.zip_filename") || !diff.NewValueKnown("content.0.exclude") {
+		return diff.SetNewComputed("content_hash")
+	}
+
+	content, err := ZipPathToBytesWithExcludes(
+		diff.Get("content.0.zip_filename").(string),
+		expandFunctionContentExcludes(diff.Get("content.0.exclude")),
+	)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// content may be created by another resource during apply
+			return diff.SetNewComputed("content_hash")
+		}
+		return fmt.Errorf("Cannot define content for Yandex Cloud Function: %s", err)
+	}
+
+	if hash := functionContentHash(content); hash != diff.Get("content_hash").(string) {
+		return diff.SetNew("content_hash", hash)
+	}
+	return nil
+}
+
 func mergeFunctionMountsAndStorageMounts(mounts []interface{}, storageMounts []interface{}) interface{} {
 	var (
 		uniqueMounts = make(map[string]struct{})
@@ -811,12 +932,18 @@ func expandLastVersion(d *schema.ResourceData) (*functions.CreateFunctionVersion
 		}
 		versionReq.PackageSource = &functions.CreateFunctionVersionRequest_Package{Package: pkg}
 	} else if _, ok := d.GetOk("content"); ok {
-		content, err := ZipPathToBytes(d.Get("content.0.zip_filename").(string))
+		content, err := ZipPathToBytesWithExcludes(
+			d.Get("content.0.zip_filename").(string),
+			expandFunctionContentExcludes(d.Get("content.0.exclude")),
+		)
 		if err != nil {
 			return nil, fmt.Errorf("Cannot define content for Yandex Cloud Function: %s", err)
 		}
-		if size := len(content); size > versionCreateSourceContentMaxBytes {
-			return nil, fmt.Errorf("Zip archive content size %v exceeds the maximum size %v, use object storage to upload the content", size, versionCreateSourceContentMaxBytes)
+		if size := len(content); size > versionCreateSourceContentMaxBytes && d.Get("content.0.staging_bucket").(string) == "" {
+			return nil, fmt.Errorf("Zip archive content size %v exceeds the maximum size %v, set content.0.staging_bucket to upload the content to object storage", size, versionCreateSourceContentMaxBytes)
+		}
+		if err := d.Set("content_hash", functionContentHash(content)); err != nil {
+			return nil, err
 		}
 		versionReq.PackageSource = &functions.CreateFunctionVersionRequest_Content{Content: content}
 	} else {
@@ -1050,19 +1177,32 @@ func flattenFunctionMetadataOptions(version *functions.Version) []map[string]int
 	return []map[string]interface{}{metadataOptions}
 }
 
-func zipPathToWriter(root string, buffer io.Writer) error {
+func zipPathToWriter(root string, excludes []string, buffer io.Writer) error {
 	rootDir := filepath.Dir(root)
 	zipWriter := zip.NewWriter(buffer)
+	// filepath.Walk visits files in lexical order, so the entries are sorted
 	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
+		if path != root && isExcludedFromZip(root, path, excludes) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
 		if info.IsDir() {
 			return nil
 		}
 
 		rel := strings.TrimPrefix(path, rootDir)
-		entry, err := zipWriter.Create(rel)
+		header := &zip.FileHeader{
+			Name:     rel,
+			Method:   zip.Deflate,
+			Modified: zipModifiedTime,
+		}
+		header.SetMode(info.Mode())
+		entry, err := zipWriter.CreateHeader(header)
 		if err != nil {
 			return err
 		}
@@ -1090,7 +1230,30 @@ func zipPathToWriter(root string, buffer io.Writer) error {
 	return nil
 }
 
+func isExcludedFromZip(root, path string, excludes []string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return false
+	}
+	rel = filepath.ToSlash(rel)
+	for _, pattern := range excludes {
+		if ok, _ := filepath.Match(pattern, rel); ok {
+			return true
+		}
+		if ok, _ := filepath.Match(pattern, filepath.Base(path)); ok {
+			return true
+		}
+	}
+	return false
+}
+
 func ZipPathToBytes(root string) ([]byte, error) {
+	return ZipPathToBytesWithExcludes(root, nil)
+}
+
+// ZipPathToBytesWithExcludes zips the given path, skipping files and directories that match excludes.
+// Entries are sorted and have fixed modification times, so the same files always give the same archive.
+func ZipPathToBytesWithExcludes(root string, excludes []string) ([]byte, error) {
 
 	// first, check if the path corresponds to already zipped file
 	info, err := os.Stat(root)
@@ -1115,13 +1278,28 @@ func ZipPathToBytes(root string) ([]byte, error) {
 
 	// do real zipping of the given path
 	var buffer bytes.Buffer
-	err = zipPathToWriter(root, &buffer)
+	err = zipPathToWriter(root, excludes, &buffer)
 	if err != nil {
 		return nil, err
 	}
 	return buffer.Bytes(), nil
 }
 
+func expandFunctionContentExcludes(v interface{}) []string {
+	var excludes []string
+	for _, e := range v.([]interface{}) {
+		if pattern, ok := e.(string); ok && pattern != "" {
+			excludes = append(excludes, pattern)
+		}
+	}
+	return excludes
+}
+
+func functionContentHash(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
 func isZipContent(buf []byte) bool {
 	return len(buf) > 3 &&
 		buf[0] == 0x50 && buf[1] == 0x4B &&
@@ -1,9 +1,12 @@
 package yandex
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -13,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/logging/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/functions/v1"
 )
@@ -1153,3 +1157,41 @@ func testWriteResourceYandexFunction(
 	}
 	fprintfLn(sb, "}")
 }
+
+func TestZipPathToBytesWithExcludes(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"main.py":            "print('hello')",
+		"lib/util.py":        "pass",
+		"lib/util_test.py":   "pass",
+		".git/config":        "[core]",
+		"node_modules/a.js":  "",
+		"data/ignored.pyc":   "",
+		"data/included.json": "{}",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	excludes := []string{".git", "node_modules", "*.pyc", "lib/*_test.py"}
+	content, err := ZipPathToBytesWithExcludes(root, excludes)
+	require.NoError(t, err)
+
+	reader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+	var names []string
+	for _, f := range reader.File {
+		names = append(names, strings.TrimPrefix(f.Name, "/"))
+		assert.Equal(t, zipModifiedTime, f.Modified.UTC())
+	}
+	assert.Equal(t, []string{"data/included.json", "lib/util.py", "main.py"}, names)
+
+	// touching files must not change the archive
+	later := zipModifiedTime.AddDate(40, 0, 0)
+	require.NoError(t, os.Chtimes(filepath.Join(root, "main.py"), later, later))
+	again, err := ZipPathToBytesWithExcludes(root, excludes)
+	require.NoError(t, err)
+	assert.Equal(t, functionContentHash(content), functionContentHash(again))
+}
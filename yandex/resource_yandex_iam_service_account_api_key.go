@@ -21,6 +21,10 @@ func resourceYandexIAMServiceAccountAPIKey() *schema.Resource {
 		Update:      resourceYandexIAMServiceAccountAPIKeyUpdate,
 		Delete:      resourceYandexIAMServiceAccountAPIKeyDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Schema: ExtendWithOutputToLockbox(map[string]*schema.Schema{
 			"service_account_id": {
 				Type:        schema.TypeString,
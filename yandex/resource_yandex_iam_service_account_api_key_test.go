@@ -43,6 +43,18 @@ func TestAccServiceAccountAPIKey_basic(t *testing.T) {
 					testAccCheckCreatedAtAttr(resourceName),
 				),
 			},
+			{
+				ResourceName:       resourceName,
+				ImportState:        true,
+				ImportStateVerify:  true,
+				ImportStatePersist: true,
+				// secret material can not be read from the API
+				ImportStateVerifyIgnore: []string{"secret_key"},
+			},
+			{
+				Config:   testAccServiceAccountAPIKeyConfig_update(accountName, accountDesc),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -19,6 +19,10 @@ func resourceYandexIAMServiceAccountKey() *schema.Resource {
 		Update:      resourceYandexIAMServiceAccountKeyUpdate,
 		Delete:      resourceYandexIAMServiceAccountKeyDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexIAMServiceAccountKeyImportState,
+		},
+
 		Schema: ExtendWithOutputToLockbox(map[string]*schema.Schema{
 			"service_account_id": {
 				Type:        schema.TypeString,
@@ -175,6 +179,13 @@ func resourceYandexIAMServiceAccountKeyRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
+// resourceYandexIAMServiceAccountKeyImportState sets the default format, since the key is read in it.
+// The private key can not be read from the API and is left empty.
+func resourceYandexIAMServiceAccountKeyImportState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	d.Set("format", "PEM_FILE")
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceYandexIAMServiceAccountKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -43,6 +43,18 @@ func TestAccServiceAccountKey_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "description", "updated description"),
 				),
 			},
+			{
+				ResourceName:       resourceName,
+				ImportState:        true,
+				ImportStateVerify:  true,
+				ImportStatePersist: true,
+				// secret material can not be read from the API
+				ImportStateVerifyIgnore: []string{"private_key"},
+			},
+			{
+				Config:   testAccServiceAccountKeyConfig(accountName, accountDesc, "updated description"),
+				PlanOnly: true,
+			},
 		},
 	})
 }
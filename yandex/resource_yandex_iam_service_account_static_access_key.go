@@ -19,6 +19,10 @@ func resourceYandexIAMServiceAccountStaticAccessKey() *schema.Resource {
 		Update:      resourceYandexIAMServiceAccountStaticAccessKeyUpdate,
 		Delete:      resourceYandexIAMServiceAccountStaticAccessKeyDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Schema: ExtendWithOutputToLockbox(map[string]*schema.Schema{
 			"service_account_id": {
 				Type:        schema.TypeString,
@@ -35,6 +35,18 @@ func TestAccServiceAccountStaticAccessKey_basic(t *testing.T) {
 					testAccCheckCreatedAtAttr(resourceName),
 				),
 			},
+			{
+				ResourceName:       resourceName,
+				ImportState:        true,
+				ImportStateVerify:  true,
+				ImportStatePersist: true,
+				// secret material can not be read from the API
+				ImportStateVerifyIgnore: []string{"secret_key"},
+			},
+			{
+				Config:   testAccServiceAccountStaticAccessKeyConfig(accountName, accountDesc),
+				PlanOnly: true,
+			},
 		},
 	})
 }
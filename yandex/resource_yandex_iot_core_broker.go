@@ -1,6 +1,7 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/logging/v1"
@@ -21,6 +22,10 @@ func resourceYandexIoTCoreBroker() *schema.Resource {
 		Update: resourceYandexIoTCoreBrokerUpdate,
 		Delete: resourceYandexIoTCoreBrokerDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexIoTCoreBrokerImportState,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexIoTDefaultTimeout),
 			Update: schema.DefaultTimeout(yandexIoTDefaultTimeout),
@@ -205,6 +210,26 @@ func resourceYandexIoTCoreBrokerRead(d *schema.ResourceData, meta interface{}) e
 	return flattenYandexIoTCoreBroker(d, broker)
 }
 
+// resourceYandexIoTCoreBrokerImportState restores certificates, which are not read back by Read.
+func resourceYandexIoTCoreBrokerImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	certsResp, err := config.sdk.IoT().Broker().Broker().ListCertificates(ctx, &iot.ListBrokerCertificatesRequest{BrokerId: d.Id()})
+	if err != nil {
+		return nil, fmt.Errorf("Error while requesting API to list certificates of IoT Broker %q: %s", d.Id(), err)
+	}
+
+	var certs []string
+	for _, cert := range certsResp.Certificates {
+		certs = append(certs, cert.CertificateData)
+	}
+	if err := d.Set("certificates", flattenIoTSet(certs)); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceYandexIoTCoreBrokerDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
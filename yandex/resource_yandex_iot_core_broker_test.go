@@ -81,6 +81,11 @@ func TestAccYandexIoTCoreBroker_basic(t *testing.T) {
 					testAccCheckCreatedAtAttr(iotBrokerResource),
 				),
 			},
+			iotCoreBrokerImportStep(),
+			{
+				Config:   testYandexIoTCoreBrokerBasic(brokerName),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -159,10 +164,30 @@ func TestAccYandexIoTCoreBroker_update(t *testing.T) {
 					testYandexIoTCoreStoreBrokerCertificates(authInfo, &broker),
 				),
 			},
+			iotCoreBrokerImportStep(),
+			{
+				Config: testYandexIoTCoreBrokerFull(
+					brokerName+"_updated",
+					"description_updated",
+					"label_key_updated",
+					"label_updated",
+					"DEBUG",
+					string(certUpdated)),
+				PlanOnly: true,
+			},
 		},
 	})
 }
 
+func iotCoreBrokerImportStep() resource.TestStep {
+	return resource.TestStep{
+		ResourceName:       iotBrokerResource,
+		ImportState:        true,
+		ImportStateVerify:  true,
+		ImportStatePersist: true,
+	}
+}
+
 func testYandexIoTCoreBrokerExists(name string, broker *iot.Broker) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
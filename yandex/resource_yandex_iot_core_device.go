@@ -20,6 +20,10 @@ func resourceYandexIoTCoreDevice() *schema.Resource {
 		Update: resourceYandexIoTCoreDeviceUpdate,
 		Delete: resourceYandexIoTCoreDeviceDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexIoTCoreDeviceImportState,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexIoTDefaultTimeout),
 			Update: schema.DefaultTimeout(yandexIoTDefaultTimeout),
@@ -183,6 +187,38 @@ func resourceYandexIoTCoreDeviceRead(d *schema.ResourceData, meta interface{}) e
 	return flattenYandexIoTCoreDevice(d, device)
 }
 
+// resourceYandexIoTCoreDeviceImportState restores certificates and aliases, which are not read back by Read.
+// Passwords can not be read from the API and are left empty.
+func resourceYandexIoTCoreDeviceImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	device, err := config.sdk.IoT().Devices().Device().Get(ctx, &iot.GetDeviceRequest{
+		DeviceId:   d.Id(),
+		DeviceView: iot.DeviceView_FULL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error while requesting API to get IoT Device %q: %s", d.Id(), err)
+	}
+	if err := d.Set("aliases", device.TopicAliases); err != nil {
+		return nil, err
+	}
+
+	certsResp, err := config.sdk.IoT().Devices().Device().ListCertificates(ctx, &iot.ListDeviceCertificatesRequest{DeviceId: d.Id()})
+	if err != nil {
+		return nil, fmt.Errorf("Error while requesting API to list certificates of IoT Device %q: %s", d.Id(), err)
+	}
+
+	var certs []string
+	for _, cert := range certsResp.Certificates {
+		certs = append(certs, cert.CertificateData)
+	}
+	if err := d.Set("certificates", flattenIoTSet(certs)); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceYandexIoTCoreDeviceDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
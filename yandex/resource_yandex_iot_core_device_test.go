@@ -109,6 +109,11 @@ func TestAccYandexIoTCoreDevice_basic(t *testing.T) {
 					testAccCheckCreatedAtAttr(iotDeviceResource),
 				),
 			},
+			iotCoreDeviceImportStep(),
+			{
+				Config:   testYandexIoTCoreDeviceBasic(registryName, deviceName),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -188,10 +193,23 @@ func TestAccYandexIoTCoreDevice_update(t *testing.T) {
 					testYandexIoTCoreDeviceContainsAlias(&device, "$devices/{id}/events/updated", "aaa/bbb_updated"),
 				),
 			},
+			iotCoreDeviceImportStep(),
 		},
 	})
 }
 
+// iotCoreDeviceImportStep imports the device into the state. Passwords can not be read from the API,
+// so the plan after the import is only empty if the device has no passwords.
+func iotCoreDeviceImportStep() resource.TestStep {
+	return resource.TestStep{
+		ResourceName:            iotDeviceResource,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStatePersist:      true,
+		ImportStateVerifyIgnore: []string{"passwords"},
+	}
+}
+
 func testYandexIoTCoreDeviceExists(registryName string, deviceName string, registry *iot.Registry, device *iot.Device) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		registryFunc := testYandexIoTCoreRegistryExists(registryName, registry)
@@ -26,6 +26,10 @@ func resourceYandexIoTCoreRegistry() *schema.Resource {
 		Update: resourceYandexIoTCoreRegistryUpdate,
 		Delete: resourceYandexIoTCoreRegistryDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexIoTCoreRegistryImportState,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexIoTDefaultTimeout),
 			Update: schema.DefaultTimeout(yandexIoTDefaultTimeout),
@@ -225,6 +229,27 @@ func resourceYandexIoTCoreRegistryRead(d *schema.ResourceData, meta interface{})
 	return flattenYandexIoTCoreRegistry(d, registry)
 }
 
+// resourceYandexIoTCoreRegistryImportState restores certificates, which are not read back by Read.
+// Passwords can not be read from the API and are left empty.
+func resourceYandexIoTCoreRegistryImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	certsResp, err := config.sdk.IoT().Devices().Registry().ListCertificates(ctx, &iot.ListRegistryCertificatesRequest{RegistryId: d.Id()})
+	if err != nil {
+		return nil, fmt.Errorf("Error while requesting API to list certificates of IoT Registry %q: %s", d.Id(), err)
+	}
+
+	var certs []string
+	for _, cert := range certsResp.Certificates {
+		certs = append(certs, cert.CertificateData)
+	}
+	if err := d.Set("certificates", flattenIoTSet(certs)); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceYandexIoTCoreRegistryDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -83,6 +83,11 @@ func TestAccYandexIoTCoreRegistry_basic(t *testing.T) {
 					testAccCheckCreatedAtAttr(iotRegistryResource),
 				),
 			},
+			iotCoreRegistryImportStep(),
+			{
+				Config:   testYandexIoTCoreRegistryBasic(registryName),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -173,10 +178,23 @@ func TestAccYandexIoTCoreRegistry_update(t *testing.T) {
 					testYandexIoTCoreNoChangePasswords(authInfo, &registry),
 				),
 			},
+			iotCoreRegistryImportStep(),
 		},
 	})
 }
 
+// iotCoreRegistryImportStep imports the registry into the state. Passwords can not be read from the API,
+// so the plan after the import is only empty if the registry has no passwords.
+func iotCoreRegistryImportStep() resource.TestStep {
+	return resource.TestStep{
+		ResourceName:            iotRegistryResource,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStatePersist:      true,
+		ImportStateVerifyIgnore: []string{"passwords"},
+	}
+}
+
 func testYandexIoTCoreRegistryExists(name string, registry *iot.Registry) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -30,6 +32,10 @@ func resourceYandexLockboxSecretVersion() *schema.Resource {
 		ReadContext:   resourceYandexLockboxSecretVersionRead,
 		CreateContext: resourceYandexLockboxSecretVersionCreate,
 		DeleteContext: resourceYandexLockboxSecretVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexLockboxSecretVersionImportState,
+		},
 		// UpdateContext: nil, // updates are not supported, all fields have ForceNew: true
 
 		Timeouts: &schema.ResourceTimeout{
@@ -96,8 +102,9 @@ func resourceYandexLockboxSecretVersion() *schema.Resource {
 						},
 					},
 				},
-				ForceNew: true,
-				Optional: true,
+				ForceNew:         true,
+				Optional:         true,
+				DiffSuppressFunc: lockboxSecretVersionImportedEntryDiffSuppress,
 			},
 
 			"secret_id": {
@@ -272,6 +279,88 @@ func resourceYandexLockboxSecretVersionDelete(ctx context.Context, d *schema.Res
 	return nil
 }
 
+// resourceYandexLockboxSecretVersionImportState imports the version by the {secret_id}/{version_id} identifier.
+// Only the keys of the entries are imported: their values are kept out of the state,
+// and lockboxSecretVersionImportedEntryDiffSuppress ignores the configured ones.
+func resourceYandexLockboxSecretVersionImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	secretID, versionID, ok := strings.Cut(d.Id(), "/")
+	if !ok || secretID == "" || versionID == "" {
+		return nil, fmt.Errorf("invalid lockbox secret version specifier %q, expecting {secret_id}/{version_id}", d.Id())
+	}
+
+	version, err := findLockboxSecretVersion(ctx, config, secretID, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(versionID)
+	d.Set("secret_id", secretID)
+	d.Set("description", version.Description)
+
+	if version.PayloadSpecification != nil {
+		// entries must be omitted for secrets with a payload specification
+		return []*schema.ResourceData{d}, nil
+	}
+
+	entries := make([]interface{}, 0, len(version.PayloadEntryKeys))
+	for _, key := range version.PayloadEntryKeys {
+		entries = append(entries, map[string]interface{}{
+			"key": key,
+		})
+	}
+	if err := d.Set("entries", entries); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// lockboxSecretVersionImportedEntryDiffSuppress suppresses the changes of the values of imported entries,
+// i.e. entries that have neither text_value nor command in the state, so that they are only compared by key.
+func lockboxSecretVersionImportedEntryDiffSuppress(k, _, _ string, d *schema.ResourceData) bool {
+	parts := strings.SplitN(k, ".", 3)
+	if len(parts) < 3 || parts[2] == "key" {
+		return false
+	}
+	i, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return false
+	}
+
+	old, _ := d.GetChange("entries")
+	entries := old.([]interface{})
+	if i >= len(entries) {
+		return false
+	}
+	entry, ok := entries[i].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	command, _ := entry["command"].([]interface{})
+	return entry["key"] != "" && entry["text_value"] == "" && len(command) == 0
+}
+
+func findLockboxSecretVersion(ctx context.Context, config *Config, secretID, versionID string) (*lockbox.Version, error) {
+	req := &lockbox.ListVersionsRequest{SecretId: secretID}
+	for {
+		resp, err := config.sdk.LockboxSecret().Secret().ListVersions(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("could not list versions of secret %v: %s", secretID, err)
+		}
+		for _, version := range resp.Versions {
+			if version.Id == versionID {
+				return version, nil
+			}
+		}
+		if resp.NextPageToken == "" {
+			return nil, fmt.Errorf("version %v of secret %v not found", versionID, secretID)
+		}
+		req.PageToken = resp.NextPageToken
+	}
+}
+
 // Removed keys will be added as lockbox.PayloadEntryChange with an empty value, to remove the key.
 // We're simulating the behavior of PayloadChangeKind.FULL, but this option is not available in the public API.
 func addEntryChangesForRemovedKeys(currentEntries []*lockbox.Payload_Entry, entryChanges []*lockbox.PayloadEntryChange) []*lockbox.PayloadEntryChange {
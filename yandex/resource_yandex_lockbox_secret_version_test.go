@@ -1,8 +1,16 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"testing"
+
+	terraform2 "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccLockboxVersion_basic(t *testing.T) {
@@ -25,6 +33,87 @@ func TestAccLockboxVersion_delete_current_version(t *testing.T) {
 	commonTestAccLockboxVersion_delete_current_version(t, lockboxVersionOriginalOptions)
 }
 
+func TestAccLockboxVersion_import(t *testing.T) {
+	secretName := "a" + acctest.RandString(10)
+	versionResource := "yandex_lockbox_secret_version.basic_version"
+	entries := []*lockboxEntryCheck{
+		{Key: "key1", Val: "val1"},
+		{Key: "key2", Val: "val2"},
+	}
+	config := testAccLockboxSecretAndVersions(secretName, &lockboxVersionsData{
+		options: lockboxVersionOriginalOptions,
+		versions: []*lockboxVersionData{
+			{ResourceName: "basic_version", Description: "basic", Entries: entries},
+		},
+	})
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckYandexLockboxSecretAllDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  testAccCheckYandexLockboxVersionEntries(versionResource, entries),
+			},
+			{
+				ResourceName:       versionResource,
+				ImportState:        true,
+				ImportStateVerify:  true,
+				ImportStatePersist: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[versionResource]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", versionResource)
+					}
+					return rs.Primary.Attributes["secret_id"] + "/" + rs.Primary.ID, nil
+				},
+				// entry values are not imported
+				ImportStateVerifyIgnore: []string{"entries.0.text_value", "entries.1.text_value"},
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestLockboxSecretVersionImportedEntryDiff(t *testing.T) {
+	state := &terraform2.InstanceState{
+		ID: "e6qversion",
+		Attributes: map[string]string{
+			"id":                  "e6qversion",
+			"secret_id":           "e6qsecret",
+			"entries.#":           "1",
+			"entries.0.key":       "key1",
+			"entries.0.command.#": "0",
+		},
+	}
+
+	// the value of an imported entry is unknown, it is not compared
+	config := terraform2.NewResourceConfigRaw(map[string]interface{}{
+		"secret_id": "e6qsecret",
+		"entries": []interface{}{
+			map[string]interface{}{"key": "key1", "text_value": "val1"},
+		},
+	})
+	diff, err := resourceYandexLockboxSecretVersion().SimpleDiff(context.Background(), state, config, nil)
+	require.NoError(t, err)
+	assert.True(t, diff == nil || !diff.RequiresNew(), "unexpected diff: %v", diff)
+
+	// the key of an imported entry is still compared
+	config = terraform2.NewResourceConfigRaw(map[string]interface{}{
+		"secret_id": "e6qsecret",
+		"entries": []interface{}{
+			map[string]interface{}{"key": "key2", "text_value": "val2"},
+		},
+	})
+	diff, err = resourceYandexLockboxSecretVersion().SimpleDiff(context.Background(), state, config, nil)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	assert.True(t, diff.RequiresNew())
+}
+
 var lockboxVersionOriginalOptions = &lockboxVersionOptions{
 	resourceType: "yandex_lockbox_secret_version",
 	entriesToHcl: linesForEntries,
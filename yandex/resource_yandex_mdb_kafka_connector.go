@@ -1,9 +1,11 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,8 +16,10 @@ import (
 	"google.golang.org/genproto/protobuf/field_mask"
 )
 
+const yandexMDBKafkaConnectorWaitInterval = 10 * time.Second
+
 func resourceYandexMDBKafkaConnector() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Description: "Manages a connector of a Kafka cluster within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-kafka/concepts).",
 
 		Create: resourceYandexMDBKafkaConnectorCreate,
@@ -48,79 +52,99 @@ func resourceYandexMDBKafkaConnector() *schema.Resource {
 			},
 			"properties": {
 				Type:        schema.TypeMap,
-				Description: "Additional properties for connector.",
+				Description: "Additional properties for connector. Settings of the connector that are not modelled by its connector-specific config block can be passed here as is.",
 				Optional:    true,
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
-			"connector_config_mirrormaker": {
-				Type:        schema.TypeList,
-				Description: "Settings for MirrorMaker2 connector.",
+			"wait_for_running": {
+				Type:        schema.TypeBool,
+				Description: "Wait for the connector to be `RUNNING` after it is created or updated. The apply fails if the connector goes to `ERROR` or `PAUSED` status instead.",
 				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"topics": {
-							Type:        schema.TypeString,
-							Description: "The pattern for topic names to be replicated.",
-							Required:    true,
-						},
-						"source_cluster": {
-							Type:        schema.TypeList,
-							Description: "Settings for source cluster.",
-							Required:    true,
-							MaxItems:    1,
-							Elem:        resourceYandexMDBKafkaClusterConnectionSpec(),
-						},
-						"target_cluster": {
-							Type:        schema.TypeList,
-							Description: "Settings for target cluster.",
-							Required:    true,
-							MaxItems:    1,
-							Elem:        resourceYandexMDBKafkaClusterConnectionSpec(),
-						},
-						"replication_factor": {
-							Type:        schema.TypeInt,
-							Description: "Replication factor for topics created in target cluster.",
-							Required:    true,
-						},
-					},
-				},
 			},
-			"connector_config_s3_sink": {
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Current status of the connector.",
+				Computed:    true,
+			},
+			"health": {
+				Type:        schema.TypeString,
+				Description: "Health of the connector.",
+				Computed:    true,
+			},
+		},
+	}
+
+	for _, connectorType := range kafkaConnectorTypes {
+		resource.Schema[connectorType.configKey] = &schema.Schema{
+			Type:        schema.TypeList,
+			Description: connectorType.description,
+			Optional:    true,
+			Elem:        connectorType.schema(),
+		}
+	}
+	return resource
+}
+
+func resourceYandexMDBKafkaConnectorMirrormakerConfig() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"topics": {
+				Type:        schema.TypeString,
+				Description: "The pattern for topic names to be replicated.",
+				Required:    true,
+			},
+			"source_cluster": {
 				Type:        schema.TypeList,
-				Description: "Settings for S3 Sink connector.",
-				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"topics": {
-							Type:        schema.TypeString,
-							Description: "The pattern for topic names to be copied to s3 bucket.",
-							Required:    true,
-						},
-						"file_compression_type": {
-							Type:        schema.TypeString,
-							Description: "Compression type for messages. Cannot be changed.",
-							Required:    true,
-							ForceNew:    true,
-						},
-						"file_max_records": {
-							Type:        schema.TypeInt,
-							Description: "Max records per file.",
-							Optional:    true,
-						},
-						"s3_connection": {
-							Type:        schema.TypeList,
-							Description: "Settings for connection to s3-compatible storage.",
-							Required:    true,
-							MaxItems:    1,
-							Elem:        resourceYandexMDBKafkaS3ConnectionSpec(),
-						},
-					},
-				},
+				Description: "Settings for source cluster.",
+				Required:    true,
+				MaxItems:    1,
+				Elem:        resourceYandexMDBKafkaClusterConnectionSpec(),
+			},
+			"target_cluster": {
+				Type:        schema.TypeList,
+				Description: "Settings for target cluster.",
+				Required:    true,
+				MaxItems:    1,
+				Elem:        resourceYandexMDBKafkaClusterConnectionSpec(),
+			},
+			"replication_factor": {
+				Type:        schema.TypeInt,
+				Description: "Replication factor for topics created in target cluster.",
+				Required:    true,
 			},
 		},
 	}
+}
 
+func resourceYandexMDBKafkaConnectorS3SinkConfig() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"topics": {
+				Type:        schema.TypeString,
+				Description: "The pattern for topic names to be copied to s3 bucket.",
+				Required:    true,
+			},
+			"file_compression_type": {
+				Type:        schema.TypeString,
+				Description: "Compression type for messages. Cannot be changed.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"file_max_records": {
+				Type:        schema.TypeInt,
+				Description: "Max records per file.",
+				Optional:    true,
+			},
+			"s3_connection": {
+				Type:        schema.TypeList,
+				Description: "Settings for connection to s3-compatible storage.",
+				Required:    true,
+				MaxItems:    1,
+				Elem:        resourceYandexMDBKafkaS3ConnectionSpec(),
+			},
+		},
+	}
 }
 
 func resourceYandexMDBKafkaClusterConnectionSpec() *schema.Resource {
@@ -254,6 +278,9 @@ func resourceYandexMDBKafkaConnectorCreate(d *schema.ResourceData, meta interfac
 	}
 	log.Printf("[DEBUG] Finished creating Kafka conector %q", conectorName)
 
+	if err = waitKafkaConnectorRunning(ctx, config, d); err != nil {
+		return err
+	}
 	return resourceYandexMDBKafkaConnectorRead(d, meta)
 }
 
@@ -291,27 +318,24 @@ func resourceYandexMDBKafkaConnectorRead(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	switch conn.GetConnectorConfig().(type) {
-	case *kafka.Connector_ConnectorConfigMirrormaker:
-		cfg, err := flattenKafkaConnectorMirrormaker(conn.GetConnectorConfigMirrormaker())
-		if err != nil {
-			return err
-		}
-		if err = d.Set("connector_config_mirrormaker", cfg); err != nil {
-			return err
-		}
-	case *kafka.Connector_ConnectorConfigS3Sink:
-		cfg, err := flattenKafkaConnectorS3Sink(conn.GetConnectorConfigS3Sink(), d)
+	if err = d.Set("status", conn.GetStatus().String()); err != nil {
+		return err
+	}
+	if err = d.Set("health", conn.GetHealth().String()); err != nil {
+		return err
+	}
+
+	for _, connectorType := range kafkaConnectorTypes {
+		cfg, err := connectorType.flatten(conn, d)
 		if err != nil {
 			return err
 		}
-		if err = d.Set("connector_config_s3_sink", cfg); err != nil {
-			return err
+		if cfg == nil {
+			continue
 		}
-	default:
-		return fmt.Errorf("this type of connector is not supported by current version of terraform provider")
+		return d.Set(connectorType.configKey, cfg)
 	}
-	return nil
+	return fmt.Errorf("this type of connector is not supported by current version of terraform provider")
 }
 
 func resourceYandexMDBKafkaConnectorUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -360,6 +384,9 @@ func resourceYandexMDBKafkaConnectorUpdate(d *schema.ResourceData, meta interfac
 
 	log.Printf("[DEBUG] Finished updating Kafka connector %q", connName)
 
+	if err = waitKafkaConnectorRunning(ctx, config, d); err != nil {
+		return err
+	}
 	return resourceYandexMDBKafkaConnectorRead(d, meta)
 }
 
@@ -393,6 +420,42 @@ func resourceYandexMDBKafkaConnectorDelete(d *schema.ResourceData, meta interfac
 	return nil
 }
 
+// waitKafkaConnectorRunning polls the connector until it is RUNNING, if wait_for_running is set.
+// The API doesn't expose the traces of the failed tasks, so the error only names the status and health of the connector.
+func waitKafkaConnectorRunning(ctx context.Context, config *Config, d *schema.ResourceData) error {
+	if !d.Get("wait_for_running").(bool) {
+		return nil
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	connName := d.Get("name").(string)
+	for {
+		conn, err := config.sdk.MDB().Kafka().Connector().Get(ctx, &kafka.GetConnectorRequest{
+			ClusterId:     clusterID,
+			ConnectorName: connName,
+		})
+		if err != nil {
+			return fmt.Errorf("error while waiting for connector %q in Kafka Cluster %q to be running: %s", connName, clusterID, err)
+		}
+
+		switch conn.GetStatus() {
+		case kafka.Connector_RUNNING:
+			log.Printf("[DEBUG] Kafka connector %q is running", connName)
+			return nil
+		case kafka.Connector_ERROR, kafka.Connector_PAUSED:
+			return fmt.Errorf("connector %q in Kafka Cluster %q is %s (health %s) instead of RUNNING, see the logs of the cluster for the traces of the failed tasks",
+				connName, clusterID, conn.GetStatus(), conn.GetHealth())
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("connector %q in Kafka Cluster %q is not running in time, last status is %s (health %s)",
+				connName, clusterID, conn.GetStatus(), conn.GetHealth())
+		case <-time.After(yandexMDBKafkaConnectorWaitInterval):
+		}
+	}
+}
+
 func getWrapedInt64(d *schema.ResourceData, name string) *wrappers.Int64Value {
 	val, ok := d.GetOk(name)
 	if !ok {
@@ -414,13 +477,11 @@ func buildKafkaConnectorSpec(d *schema.ResourceData) (*kafka.ConnectorSpec, erro
 	}
 	connSpec.Properties = props
 	var countOfSpecificConnectorConfigs int64
-	if _, ok := d.GetOk("connector_config_mirrormaker"); ok {
-		connSpec.SetConnectorConfigMirrormaker(buildKafkaMirrorMakerSpec(d))
-		countOfSpecificConnectorConfigs++
-	}
-	if _, ok := d.GetOk("connector_config_s3_sink"); ok {
-		connSpec.SetConnectorConfigS3Sink(buildKafkaS3SinkConnectorSpec(d))
-		countOfSpecificConnectorConfigs++
+	for _, connectorType := range kafkaConnectorTypes {
+		if _, ok := d.GetOk(connectorType.configKey); ok {
+			connectorType.expand(d, connSpec)
+			countOfSpecificConnectorConfigs++
+		}
 	}
 	if countOfSpecificConnectorConfigs == 0 {
 		return nil, fmt.Errorf("connector-specific config must be specified")
@@ -442,13 +503,11 @@ func buildKafkaConnectorUpdateSpec(d *schema.ResourceData) (*kafka.UpdateConnect
 	connSpec.Properties = props
 
 	var countOfSpecificConnectorConfigs int64
-	if _, ok := d.GetOk("connector_config_mirrormaker"); ok {
-		connSpec.SetConnectorConfigMirrormaker(buildKafkaMirrorMakerSpec(d))
-		countOfSpecificConnectorConfigs++
-	}
-	if _, ok := d.GetOk("connector_config_s3_sink"); ok {
-		connSpec.SetConnectorConfigS3Sink(buildKafkaS3SinkConnectorSpecUpdate(d))
-		countOfSpecificConnectorConfigs++
+	for _, connectorType := range kafkaConnectorTypes {
+		if _, ok := d.GetOk(connectorType.configKey); ok {
+			connectorType.expandUpdate(d, connSpec)
+			countOfSpecificConnectorConfigs++
+		}
 	}
 	if countOfSpecificConnectorConfigs > 1 {
 		return nil, fmt.Errorf("must be specified only one connector-specific config")
@@ -533,8 +592,9 @@ func init() {
 	valPrefix := "connector_spec."
 	mdbKafkaConnectorUpdateFieldsMap[keyPrefix+"tasks_max"] = valPrefix + "tasks_max"
 	mdbKafkaConnectorUpdateFieldsMap[keyPrefix+"properties"] = valPrefix + "properties"
-	addMirrormakerUpdatePathsToFieldsMap(keyPrefix, valPrefix)
-	addS3SinkUpdatePathsToFieldsMap(keyPrefix, valPrefix)
+	for _, connectorType := range kafkaConnectorTypes {
+		connectorType.addUpdatePaths(keyPrefix, valPrefix)
+	}
 }
 
 func addMirrormakerUpdatePathsToFieldsMap(commonKeyPrefix string, commonValPrefix string) {
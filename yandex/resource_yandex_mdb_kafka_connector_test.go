@@ -406,3 +406,38 @@ func TestBuildKafkaConnectorSpecWhenNoConfigsThenError(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, "connector-specific config must be specified", err.Error())
 }
+
+func TestKafkaConnectorTypesFlattenOnlyOwnConfig(t *testing.T) {
+	conn := &kafka.Connector{
+		Name: "connector1",
+		ConnectorConfig: &kafka.Connector_ConnectorConfigS3Sink{
+			ConnectorConfigS3Sink: &kafka.ConnectorConfigS3Sink{
+				Topics:              "topics_*",
+				FileCompressionType: "gzip",
+				S3Connection: &kafka.S3Connection{
+					BucketName: "bucket",
+					Storage: &kafka.S3Connection_ExternalS3{
+						ExternalS3: &kafka.ExternalS3Storage{Endpoint: "storage.yandexcloud.net"},
+					},
+				},
+			},
+		},
+	}
+	resourceData := schema.TestResourceDataRaw(t, resourceYandexMDBKafkaConnector().Schema, map[string]interface{}{})
+
+	var flattened []string
+	for _, connectorType := range kafkaConnectorTypes {
+		cfg, err := connectorType.flatten(conn, resourceData)
+		require.NoError(t, err)
+		if cfg != nil {
+			flattened = append(flattened, connectorType.configKey)
+		}
+	}
+	assert.Equal(t, []string{"connector_config_s3_sink"}, flattened)
+}
+
+func TestKafkaConnectorTypesUpdatePaths(t *testing.T) {
+	for _, connectorType := range kafkaConnectorTypes {
+		assert.Equal(t, "connector_spec."+connectorType.configKey+".topics", mdbKafkaConnectorUpdateFieldsMap[connectorType.configKey+".0.topics"])
+	}
+}
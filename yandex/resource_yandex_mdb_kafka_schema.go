@@ -0,0 +1,270 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	yandexMDBKafkaSchemaCreateTimeout = 5 * time.Minute
+	yandexMDBKafkaSchemaReadTimeout   = 1 * time.Minute
+	yandexMDBKafkaSchemaUpdateTimeout = 5 * time.Minute
+	yandexMDBKafkaSchemaDeleteTimeout = 5 * time.Minute
+)
+
+func resourceYandexMDBKafkaSchema() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Manages the schema of a subject in the schema registry of a Kafka cluster within the Yandex Cloud. Every change of the schema registers a new version of the subject, " +
+			"the plan fails if the new schema is incompatible with the latest version according to the compatibility level of the subject. If the registry can't be reached while planning, the schema is only checked on apply. " +
+			"For more information, see [the official documentation](https://yandex.cloud/docs/managed-kafka/concepts/managed-schema-registry).\n\n" +
+			"~> The resource talks to the REST API of the schema registry on the cluster hosts, so they must be reachable from the host where Terraform runs.\n",
+
+		Create: resourceYandexMDBKafkaSchemaCreate,
+		Read:   resourceYandexMDBKafkaSchemaRead,
+		Update: resourceYandexMDBKafkaSchemaUpdate,
+		Delete: resourceYandexMDBKafkaSchemaDelete,
+
+		CustomizeDiff: resourceYandexMDBKafkaSchemaCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBKafkaSchemaCreateTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBKafkaSchemaReadTimeout),
+			Update: schema.DefaultTimeout(yandexMDBKafkaSchemaUpdateTimeout),
+			Delete: schema.DefaultTimeout(yandexMDBKafkaSchemaDeleteTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"subject": {
+				Type:        schema.TypeString,
+				Description: "The name of the subject, e.g. `<topic>-value` for the schemas of the messages of the topic. The resource owns the whole subject: all of its versions, including the ones registered outside of Terraform, are deleted permanently with the resource.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"schema_type": {
+				Type:         schema.TypeString,
+				Description:  "The type of the schema. One of `AVRO`, `PROTOBUF` or `JSON`. Default is `AVRO`.",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      kafkaSchemaTypeAvro,
+				ValidateFunc: validation.StringInSlice([]string{kafkaSchemaTypeAvro, kafkaSchemaTypeProtobuf, kafkaSchemaTypeJSON}, false),
+			},
+			"schema": {
+				Type:        schema.TypeString,
+				Description: "The schema definition. Avro and JSON schemas are compared as JSON documents.",
+				Required:    true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return kafkaSchemasEqual(d.Get("schema_type").(string), old, new)
+				},
+			},
+			"reference": {
+				Type:        schema.TypeList,
+				Description: "The schemas of other subjects that the schema refers to.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the reference: the full name of the referenced type for Avro, the import path for Protobuf or the URL for JSON schemas.",
+							Required:    true,
+						},
+						"subject": {
+							Type:        schema.TypeString,
+							Description: "The subject of the referenced schema.",
+							Required:    true,
+						},
+						"version": {
+							Type:        schema.TypeInt,
+							Description: "The version of the referenced schema.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"schema_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the schema in the registry.",
+				Computed:    true,
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Description: "The version of the subject the schema is registered with.",
+				Computed:    true,
+			},
+		},
+	}
+
+	for key, attributeSchema := range kafkaSchemaRegistryConnectionSchema() {
+		resource.Schema[key] = attributeSchema
+	}
+	return resource
+}
+
+func resourceYandexMDBKafkaSchemaCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	if err := registerKafkaSchema(ctx, config, d); err != nil {
+		return err
+	}
+
+	d.SetId(constructResourceId(d.Get("cluster_id").(string), d.Get("subject").(string)))
+	return resourceYandexMDBKafkaSchemaRead(d, meta)
+}
+
+func registerKafkaSchema(ctx context.Context, config *Config, d *schema.ResourceData) error {
+	client, err := newKafkaSchemaRegistryClient(ctx, config, d)
+	if err != nil {
+		return err
+	}
+
+	subject := d.Get("subject").(string)
+	log.Printf("[DEBUG] Registering schema of subject %q", subject)
+	id, err := client.RegisterSchema(ctx, subject, expandKafkaSchema(d))
+	if err != nil {
+		return fmt.Errorf("error while registering schema of subject %q: %w", subject, err)
+	}
+	return d.Set("schema_id", id)
+}
+
+func expandKafkaSchema(d kafkaSchemaRegistryConnection) *kafkaSchemaRegistrySchema {
+	s := &kafkaSchemaRegistrySchema{
+		SchemaType: expandKafkaSchemaType(d.Get("schema_type").(string)),
+		Schema:     d.Get("schema").(string),
+	}
+	for _, v := range d.Get("reference").([]interface{}) {
+		reference := v.(map[string]interface{})
+		s.References = append(s.References, kafkaSchemaRegistryReference{
+			Name:    reference["name"].(string),
+			Subject: reference["subject"].(string),
+			Version: reference["version"].(int),
+		})
+	}
+	return s
+}
+
+func flattenKafkaSchemaReferences(references []kafkaSchemaRegistryReference) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(references))
+	for _, reference := range references {
+		result = append(result, map[string]interface{}{
+			"name":    reference.Name,
+			"subject": reference.Subject,
+			"version": reference.Version,
+		})
+	}
+	return result
+}
+
+func resourceYandexMDBKafkaSchemaRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	client, err := newKafkaSchemaRegistryClient(ctx, config, d)
+	if err != nil {
+		return err
+	}
+
+	subject := d.Get("subject").(string)
+	s, err := client.GetLatestSchema(ctx, subject)
+	if err != nil {
+		if isKafkaSchemaRegistryNotFound(err) {
+			log.Printf("[WARN] Subject %q not found, removing schema from state", subject)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error while reading schema of subject %q: %w", subject, err)
+	}
+
+	if err = d.Set("schema_type", flattenKafkaSchemaType(s.SchemaType)); err != nil {
+		return err
+	}
+	// the registry may return the registered schema reformatted, the configured text is kept while the ID is the same
+	if s.ID != d.Get("schema_id").(int) {
+		if err = d.Set("schema", s.Schema); err != nil {
+			return err
+		}
+	}
+	if err = d.Set("reference", flattenKafkaSchemaReferences(s.References)); err != nil {
+		return err
+	}
+	if err = d.Set("schema_id", s.ID); err != nil {
+		return err
+	}
+	return d.Set("version", s.Version)
+}
+
+func resourceYandexMDBKafkaSchemaUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChanges("schema", "reference") {
+		config := meta.(*Config)
+		ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+		defer cancel()
+
+		if err := registerKafkaSchema(ctx, config, d); err != nil {
+			return err
+		}
+	}
+	return resourceYandexMDBKafkaSchemaRead(d, meta)
+}
+
+func resourceYandexMDBKafkaSchemaDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	client, err := newKafkaSchemaRegistryClient(ctx, config, d)
+	if err != nil {
+		return err
+	}
+
+	subject := d.Get("subject").(string)
+	log.Printf("[DEBUG] Deleting subject %q", subject)
+	err = client.DeleteSubject(ctx, subject)
+	if err != nil && !isKafkaSchemaRegistryNotFound(err) {
+		return fmt.Errorf("error while deleting subject %q: %w", subject, err)
+	}
+	return nil
+}
+
+// resourceYandexMDBKafkaSchemaCustomizeDiff checks the changed schema against the latest version of the subject,
+// so that an incompatible schema fails the plan instead of the apply. The registry may be unreachable while planning,
+// so the errors of the check itself are only logged as warnings and the schema is checked by the registry on apply.
+func resourceYandexMDBKafkaSchemaCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("schema", "reference") {
+		return nil
+	}
+	// the schema can only be checked once the schema and the connection settings are known
+	for _, key := range []string{"cluster_id", "username", "password", "endpoint", "ca_certificate", "subject", "schema", "reference"} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	subject := diff.Get("subject").(string)
+	client, err := newKafkaSchemaRegistryClient(ctx, meta.(*Config), diff)
+	if err != nil {
+		log.Printf("[WARN] Cannot check compatibility of schema of subject %q: %s", subject, err)
+		return nil
+	}
+
+	compatible, messages, err := client.CheckSchemaCompatibility(ctx, subject, expandKafkaSchema(diff))
+	if err != nil {
+		// the subject has no versions yet if it's not found
+		if !isKafkaSchemaRegistryNotFound(err) {
+			log.Printf("[WARN] Cannot check compatibility of schema of subject %q: %s", subject, err)
+		}
+		return nil
+	}
+	if !compatible {
+		return fmt.Errorf("schema is incompatible with the latest version of subject %q:\n%s", subject, strings.Join(messages, "\n"))
+	}
+	return nil
+}
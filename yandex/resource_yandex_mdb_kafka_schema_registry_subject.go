@@ -0,0 +1,138 @@
+package yandex
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	yandexMDBKafkaSchemaRegistrySubjectCreateTimeout = 5 * time.Minute
+	yandexMDBKafkaSchemaRegistrySubjectReadTimeout   = 1 * time.Minute
+	yandexMDBKafkaSchemaRegistrySubjectUpdateTimeout = 5 * time.Minute
+	yandexMDBKafkaSchemaRegistrySubjectDeleteTimeout = 5 * time.Minute
+)
+
+func resourceYandexMDBKafkaSchemaRegistrySubject() *schema.Resource {
+	resource := &schema.Resource{
+		Description: "Manages the compatibility level of a subject in the schema registry of a Kafka cluster within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-kafka/concepts/managed-schema-registry).\n\n~> The resource talks to the REST API of the schema registry on the cluster hosts, so they must be reachable from the host where Terraform runs.\n",
+
+		Create: resourceYandexMDBKafkaSchemaRegistrySubjectCreate,
+		Read:   resourceYandexMDBKafkaSchemaRegistrySubjectRead,
+		Update: resourceYandexMDBKafkaSchemaRegistrySubjectUpdate,
+		Delete: resourceYandexMDBKafkaSchemaRegistrySubjectDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBKafkaSchemaRegistrySubjectCreateTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBKafkaSchemaRegistrySubjectReadTimeout),
+			Update: schema.DefaultTimeout(yandexMDBKafkaSchemaRegistrySubjectUpdateTimeout),
+			Delete: schema.DefaultTimeout(yandexMDBKafkaSchemaRegistrySubjectDeleteTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"subject": {
+				Type:        schema.TypeString,
+				Description: "The name of the subject, e.g. `<topic>-value` for the schemas of the messages of the topic.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"compatibility_level": {
+				Type:         schema.TypeString,
+				Description:  "The compatibility level of the subject, which the new schemas of the subject are checked with. One of `BACKWARD`, `BACKWARD_TRANSITIVE`, `FORWARD`, `FORWARD_TRANSITIVE`, `FULL`, `FULL_TRANSITIVE` or `NONE`. When the resource is deleted, the subject returns to the global compatibility level of the registry.",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(kafkaSchemaRegistryCompatibilityLevels, false),
+			},
+		},
+	}
+
+	for key, attributeSchema := range kafkaSchemaRegistryConnectionSchema() {
+		resource.Schema[key] = attributeSchema
+	}
+	return resource
+}
+
+func resourceYandexMDBKafkaSchemaRegistrySubjectCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	client, err := newKafkaSchemaRegistryClient(ctx, config, d)
+	if err != nil {
+		return err
+	}
+
+	subject := d.Get("subject").(string)
+	if err = client.SetSubjectCompatibility(ctx, subject, d.Get("compatibility_level").(string)); err != nil {
+		return fmt.Errorf("error while setting compatibility level of subject %q: %w", subject, err)
+	}
+
+	d.SetId(constructResourceId(d.Get("cluster_id").(string), subject))
+	return resourceYandexMDBKafkaSchemaRegistrySubjectRead(d, meta)
+}
+
+func resourceYandexMDBKafkaSchemaRegistrySubjectRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	client, err := newKafkaSchemaRegistryClient(ctx, config, d)
+	if err != nil {
+		return err
+	}
+
+	subject := d.Get("subject").(string)
+	compatibility, err := client.GetSubjectCompatibility(ctx, subject)
+	if err != nil {
+		if isKafkaSchemaRegistryNotFound(err) {
+			log.Printf("[WARN] Subject %q has no compatibility level, removing it from state", subject)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error while reading compatibility level of subject %q: %w", subject, err)
+	}
+	return d.Set("compatibility_level", compatibility)
+}
+
+func resourceYandexMDBKafkaSchemaRegistrySubjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	if !d.HasChange("compatibility_level") {
+		return resourceYandexMDBKafkaSchemaRegistrySubjectRead(d, meta)
+	}
+
+	config := meta.(*Config)
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	client, err := newKafkaSchemaRegistryClient(ctx, config, d)
+	if err != nil {
+		return err
+	}
+
+	subject := d.Get("subject").(string)
+	if err = client.SetSubjectCompatibility(ctx, subject, d.Get("compatibility_level").(string)); err != nil {
+		return fmt.Errorf("error while updating compatibility level of subject %q: %w", subject, err)
+	}
+	return resourceYandexMDBKafkaSchemaRegistrySubjectRead(d, meta)
+}
+
+func resourceYandexMDBKafkaSchemaRegistrySubjectDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	client, err := newKafkaSchemaRegistryClient(ctx, config, d)
+	if err != nil {
+		return err
+	}
+
+	subject := d.Get("subject").(string)
+	err = client.DeleteSubjectCompatibility(ctx, subject)
+	if err != nil && !isKafkaSchemaRegistryNotFound(err) {
+		return fmt.Errorf("error while deleting compatibility level of subject %q: %w", subject, err)
+	}
+	return nil
+}
@@ -59,7 +59,7 @@ func resourceYandexMDBKafkaUser() *schema.Resource {
 			},
 			"permission": {
 				Type:        schema.TypeSet,
-				Description: "Set of permissions granted to the user.",
+				Description: "Set of permissions granted to the user. Permissions that are not declared are revoked. To manage the permissions with `yandex_mdb_kafka_user_permission` resources instead, add `permission` to `ignore_changes` of the `lifecycle` block.",
 				Optional:    true,
 				Set:         kafkaUserPermissionHash,
 				Elem:        resourceYandexMDBKafkaPermission(),
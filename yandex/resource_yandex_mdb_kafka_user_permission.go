@@ -0,0 +1,206 @@
+package yandex
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	yandexMDBKafkaUserPermissionCreateTimeout = 10 * time.Minute
+	yandexMDBKafkaUserPermissionReadTimeout   = 1 * time.Minute
+	yandexMDBKafkaUserPermissionUpdateTimeout = 10 * time.Minute
+	yandexMDBKafkaUserPermissionDeleteTimeout = 10 * time.Minute
+)
+
+func resourceYandexMDBKafkaUserPermission() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a single permission of a Kafka user within the Yandex Cloud, without managing the user itself. For more information, see [the official documentation](https://yandex.cloud/docs/managed-kafka/concepts/account-roles).\n\n~> Add `permission` to `ignore_changes` of the `lifecycle` block of the `yandex_mdb_kafka_user` resource of the same user and do not declare `permission` blocks in it, otherwise it revokes the permissions managed by this resource.\n",
+
+		Create: resourceYandexMDBKafkaUserPermissionCreate,
+		Read:   resourceYandexMDBKafkaUserPermissionRead,
+		Update: resourceYandexMDBKafkaUserPermissionUpdate,
+		Delete: resourceYandexMDBKafkaUserPermissionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBKafkaUserPermissionCreateTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBKafkaUserPermissionReadTimeout),
+			Update: schema.DefaultTimeout(yandexMDBKafkaUserPermissionUpdateTimeout),
+			Delete: schema.DefaultTimeout(yandexMDBKafkaUserPermissionDeleteTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the Kafka cluster.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"user_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the user that the permission is granted to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"topic_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the topic that the permission grants access to. May be a pattern, e.g. `orders-*`, or `*` for all topics.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Description:  "The role type to grant to the topic, e.g. `ACCESS_ROLE_CONSUMER` or `ACCESS_ROLE_PRODUCER`.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(getEnumValueMapKeysExt(kafka.Permission_AccessRole_value, true), false),
+			},
+			"allow_hosts": {
+				Type:        schema.TypeSet,
+				Description: "Set of hosts, to which this permission grants access to. Only ip-addresses allowed as value of single host.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceYandexMDBKafkaUserPermissionCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	permission, err := buildKafkaUserPermission(d)
+	if err != nil {
+		return err
+	}
+	clusterID := d.Get("cluster_id").(string)
+	userName := d.Get("user_name").(string)
+
+	log.Printf("[DEBUG] Granting role %s for topic %q to Kafka user %q", permission.Role, permission.TopicName, userName)
+	if err = grantKafkaUserPermission(ctx, NewKafkaUserPermissionManager(config), clusterID, userName, permission); err != nil {
+		return err
+	}
+
+	d.SetId(constructKafkaUserPermissionId(clusterID, userName, permission.TopicName, permission.Role.String()))
+	return resourceYandexMDBKafkaUserPermissionRead(d, meta)
+}
+
+func buildKafkaUserPermission(d *schema.ResourceData) (*kafka.Permission, error) {
+	role, err := parseKafkaPermissionRole(d.Get("role").(string))
+	if err != nil {
+		return nil, err
+	}
+	return &kafka.Permission{
+		TopicName:  d.Get("topic_name").(string),
+		Role:       role,
+		AllowHosts: parseKafkaPermissionAllowHosts(d.Get("allow_hosts")),
+	}, nil
+}
+
+func resourceYandexMDBKafkaUserPermissionRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	clusterID, userName, topicName, roleName, err := deconstructKafkaUserPermissionId(d.Id())
+	if err != nil {
+		return err
+	}
+	role, err := parseKafkaPermissionRole(roleName)
+	if err != nil {
+		return err
+	}
+
+	permissions, err := NewKafkaUserPermissionManager(config).GetKafkaUserPermissions(ctx, clusterID, userName)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("User %q", userName))
+	}
+
+	i := findKafkaUserPermission(permissions, topicName, role)
+	if i < 0 {
+		log.Printf("[WARN] Kafka user %q has no role %s for topic %q, removing permission from state", userName, roleName, topicName)
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("cluster_id", clusterID); err != nil {
+		return err
+	}
+	if err = d.Set("user_name", userName); err != nil {
+		return err
+	}
+	if err = d.Set("topic_name", topicName); err != nil {
+		return err
+	}
+	if err = d.Set("role", roleName); err != nil {
+		return err
+	}
+	return d.Set("allow_hosts", permissions[i].GetAllowHosts())
+}
+
+func resourceYandexMDBKafkaUserPermissionUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	if !d.HasChange("allow_hosts") {
+		return resourceYandexMDBKafkaUserPermissionRead(d, meta)
+	}
+
+	permission, err := buildKafkaUserPermission(d)
+	if err != nil {
+		return err
+	}
+	userName := d.Get("user_name").(string)
+
+	log.Printf("[DEBUG] Updating hosts of role %s for topic %q of Kafka user %q", permission.Role, permission.TopicName, userName)
+	if err = updateKafkaUserPermission(ctx, NewKafkaUserPermissionManager(config), d.Get("cluster_id").(string), userName, permission); err != nil {
+		return err
+	}
+	return resourceYandexMDBKafkaUserPermissionRead(d, meta)
+}
+
+func resourceYandexMDBKafkaUserPermissionDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	permission, err := buildKafkaUserPermission(d)
+	if err != nil {
+		return err
+	}
+	userName := d.Get("user_name").(string)
+
+	log.Printf("[DEBUG] Revoking role %s for topic %q from Kafka user %q", permission.Role, permission.TopicName, userName)
+	err = revokeKafkaUserPermission(ctx, NewKafkaUserPermissionManager(config), d.Get("cluster_id").(string), userName, permission.TopicName, permission.Role)
+	if err != nil && isStatusWithCode(err, codes.NotFound) {
+		log.Printf("[WARN] Kafka user %q not found, permission is already revoked", userName)
+		return nil
+	}
+	return err
+}
+
+func constructKafkaUserPermissionId(clusterID, userName, topicName, role string) string {
+	return strings.Join([]string{clusterID, userName, topicName, role}, ":")
+}
+
+func deconstructKafkaUserPermissionId(resourceID string) (clusterID, userName, topicName, role string, err error) {
+	parts := strings.Split(resourceID, ":")
+	if len(parts) != 4 {
+		return "", "", "", "", fmt.Errorf("invalid resource id format: %q, expected <cluster_id>:<user_name>:<topic_name>:<role>", resourceID)
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
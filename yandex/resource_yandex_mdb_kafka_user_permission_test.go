@@ -0,0 +1,175 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/mocks"
+)
+
+func TestGrantKafkaUserPermission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	modifier := mocks.NewMockKafkaUserPermissionModifier(ctrl)
+	modifier.EXPECT().GetKafkaUserPermissions(gomock.Any(), "cid", "user").Return([]*kafka.Permission{
+		{TopicName: "orders", Role: kafka.Permission_ACCESS_ROLE_PRODUCER},
+	}, nil).Times(1)
+	modifier.EXPECT().SetKafkaUserPermissions(gomock.Any(), "cid", "user", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, clusterID, userName string, permissions []*kafka.Permission) error {
+			require.Equal(t, UserPermissionsToStr([]*kafka.Permission{
+				{TopicName: "events-*", Role: kafka.Permission_ACCESS_ROLE_CONSUMER, AllowHosts: []string{"10.0.0.1"}},
+				{TopicName: "orders", Role: kafka.Permission_ACCESS_ROLE_PRODUCER},
+			}), UserPermissionsToStr(permissions))
+			return nil
+		}).Times(1)
+
+	err := grantKafkaUserPermission(context.Background(), modifier, "cid", "user", &kafka.Permission{
+		TopicName:  "events-*",
+		Role:       kafka.Permission_ACCESS_ROLE_CONSUMER,
+		AllowHosts: []string{"10.0.0.1"},
+	})
+	require.NoError(t, err)
+}
+
+func TestGrantExistingKafkaUserPermission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	modifier := mocks.NewMockKafkaUserPermissionModifier(ctrl)
+	modifier.EXPECT().GetKafkaUserPermissions(gomock.Any(), "cid", "user").Return([]*kafka.Permission{
+		{TopicName: "orders", Role: kafka.Permission_ACCESS_ROLE_PRODUCER},
+	}, nil).Times(1)
+
+	err := grantKafkaUserPermission(context.Background(), modifier, "cid", "user", &kafka.Permission{
+		TopicName: "orders",
+		Role:      kafka.Permission_ACCESS_ROLE_PRODUCER,
+	})
+	require.ErrorContains(t, err, "already has role ACCESS_ROLE_PRODUCER for topic \"orders\"")
+}
+
+func TestUpdateAndRevokeKafkaUserPermission(t *testing.T) {
+	current := []*kafka.Permission{
+		{TopicName: "orders", Role: kafka.Permission_ACCESS_ROLE_CONSUMER, AllowHosts: []string{"10.0.0.1"}},
+		{TopicName: "orders", Role: kafka.Permission_ACCESS_ROLE_PRODUCER},
+	}
+
+	ctrl := gomock.NewController(t)
+	modifier := mocks.NewMockKafkaUserPermissionModifier(ctrl)
+	modifier.EXPECT().GetKafkaUserPermissions(gomock.Any(), "cid", "user").DoAndReturn(
+		func(ctx context.Context, clusterID, userName string) ([]*kafka.Permission, error) {
+			return append([]*kafka.Permission{}, current...), nil
+		}).Times(2)
+	modifier.EXPECT().SetKafkaUserPermissions(gomock.Any(), "cid", "user", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, clusterID, userName string, permissions []*kafka.Permission) error {
+			current = permissions
+			return nil
+		}).Times(2)
+
+	err := updateKafkaUserPermission(context.Background(), modifier, "cid", "user", &kafka.Permission{
+		TopicName:  "orders",
+		Role:       kafka.Permission_ACCESS_ROLE_CONSUMER,
+		AllowHosts: []string{"10.0.0.1", "10.0.0.2"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, UserPermissionsToStr([]*kafka.Permission{
+		{TopicName: "orders", Role: kafka.Permission_ACCESS_ROLE_CONSUMER, AllowHosts: []string{"10.0.0.1", "10.0.0.2"}},
+		{TopicName: "orders", Role: kafka.Permission_ACCESS_ROLE_PRODUCER},
+	}), UserPermissionsToStr(current))
+
+	err = revokeKafkaUserPermission(context.Background(), modifier, "cid", "user", "orders", kafka.Permission_ACCESS_ROLE_PRODUCER)
+	require.NoError(t, err)
+	require.Equal(t, UserPermissionsToStr([]*kafka.Permission{
+		{TopicName: "orders", Role: kafka.Permission_ACCESS_ROLE_CONSUMER, AllowHosts: []string{"10.0.0.1", "10.0.0.2"}},
+	}), UserPermissionsToStr(current))
+}
+
+func TestKafkaUserPermissionId(t *testing.T) {
+	id := constructKafkaUserPermissionId("cid", "user", "events-*", "ACCESS_ROLE_CONSUMER")
+	clusterID, userName, topicName, role, err := deconstructKafkaUserPermissionId(id)
+	require.NoError(t, err)
+	require.Equal(t, []string{"cid", "user", "events-*", "ACCESS_ROLE_CONSUMER"}, []string{clusterID, userName, topicName, role})
+
+	_, _, _, _, err = deconstructKafkaUserPermissionId("cid:user")
+	require.Error(t, err)
+}
+
+func TestAccMDBKafkaUserPermission(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("tf-kafka")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBKafkaUserPermissionConfig(clusterName, `["10.0.0.1"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBKafkaUserHasPermissions("events-user", []*kafka.Permission{
+						{
+							TopicName:  "raw_events",
+							Role:       kafka.Permission_ACCESS_ROLE_CONSUMER,
+							AllowHosts: []string{"10.0.0.1"},
+						},
+						{
+							TopicName: "raw_events",
+							Role:      kafka.Permission_ACCESS_ROLE_PRODUCER,
+						},
+					}),
+				),
+			},
+			{
+				ResourceName:      "yandex_mdb_kafka_user_permission.consumer",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMDBKafkaUserPermissionConfig(clusterName, `["10.0.0.1", "10.0.0.2"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBKafkaUserHasPermissions("events-user", []*kafka.Permission{
+						{
+							TopicName:  "raw_events",
+							Role:       kafka.Permission_ACCESS_ROLE_CONSUMER,
+							AllowHosts: []string{"10.0.0.1", "10.0.0.2"},
+						},
+						{
+							TopicName: "raw_events",
+							Role:      kafka.Permission_ACCESS_ROLE_PRODUCER,
+						},
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccMDBKafkaUserPermissionConfig(name, allowHosts string) string {
+	return testAccMDBKafkaUserConfigStep0(name) + fmt.Sprintf(`
+resource "yandex_mdb_kafka_user" "events_user" {
+  cluster_id = yandex_mdb_kafka_cluster.foo.id
+  name       = "events-user"
+  password   = "test-password-123"
+
+  lifecycle {
+    ignore_changes = [permission]
+  }
+}
+
+resource "yandex_mdb_kafka_user_permission" "consumer" {
+  cluster_id  = yandex_mdb_kafka_cluster.foo.id
+  user_name   = yandex_mdb_kafka_user.events_user.name
+  topic_name  = "raw_events"
+  role        = "ACCESS_ROLE_CONSUMER"
+  allow_hosts = %s
+}
+
+resource "yandex_mdb_kafka_user_permission" "producer" {
+  cluster_id = yandex_mdb_kafka_cluster.foo.id
+  user_name  = yandex_mdb_kafka_user.events_user.name
+  topic_name = "raw_events"
+  role       = "ACCESS_ROLE_PRODUCER"
+}
+`, allowHosts)
+}
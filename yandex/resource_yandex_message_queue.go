@@ -1,6 +1,8 @@
 package yandex
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/url"
@@ -28,7 +30,6 @@ var sqsQueueAttributeMap = map[string]string{
 	"message_retention_seconds":   sqs.QueueAttributeNameMessageRetentionPeriod,
 	"receive_wait_time_seconds":   sqs.QueueAttributeNameReceiveMessageWaitTimeSeconds,
 	"visibility_timeout_seconds":  sqs.QueueAttributeNameVisibilityTimeout,
-	"redrive_policy":              sqs.QueueAttributeNameRedrivePolicy,
 	"arn":                         sqs.QueueAttributeNameQueueArn,
 	"fifo_queue":                  sqs.QueueAttributeNameFifoQueue,
 	"content_based_deduplication": sqs.QueueAttributeNameContentBasedDeduplication,
@@ -45,6 +46,7 @@ func resourceYandexMessageQueue() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceYandexMessageQueueCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -99,15 +101,39 @@ func resourceYandexMessageQueue() *schema.Resource {
 				ValidateFunc: validation.IntBetween(0, 43200),
 			},
 			"redrive_policy": {
-				Type:         schema.TypeString,
-				Description:  "Message redrive policy in [Dead Letter Queue](https://yandex.cloud/docs/message-queue/concepts/dlq). The source queue and DLQ must be the same type: for FIFO queues, the DLQ must also be a FIFO queue. For more information about redrive policy see [documentation](https://yandex.cloud/docs/message-queue/api-ref/queue/CreateQueue). Also you can use example in this page.",
-				Optional:     true,
-				ValidateFunc: validation.StringIsJSON,
+				Type:          schema.TypeString,
+				Description:   "Message redrive policy in [Dead Letter Queue](https://yandex.cloud/docs/message-queue/concepts/dlq). The source queue and DLQ must be the same type: for FIFO queues, the DLQ must also be a FIFO queue. For more information about redrive policy see [documentation](https://yandex.cloud/docs/message-queue/api-ref/queue/CreateQueue). Also you can use example in this page.",
+				Deprecated:    "Use the `redrive` block instead.",
+				Optional:      true,
+				ValidateFunc:  validation.StringIsJSON,
+				ConflictsWith: []string{"redrive"},
 				StateFunc: func(v interface{}) string {
 					json, _ := structure.NormalizeJsonString(v)
 					return json
 				},
 			},
+			"redrive": {
+				Type:          schema.TypeList,
+				Description:   "Message redrive policy in [Dead Letter Queue](https://yandex.cloud/docs/message-queue/concepts/dlq). The source queue and DLQ must be the same type: for FIFO queues, the DLQ must also be a FIFO queue. For more information about redrive policy see [documentation](https://yandex.cloud/docs/message-queue/api-ref/queue/CreateQueue).",
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"redrive_policy"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dead_letter_target_arn": {
+							Type:        schema.TypeString,
+							Description: "ARN of the Dead Letter Queue the messages are moved to.",
+							Required:    true,
+						},
+						"max_receive_count": {
+							Type:         schema.TypeInt,
+							Description:  "Number of times a message is received before it is moved to the Dead Letter Queue. Valid values: from 1 to 1000.",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+					},
+				},
+			},
 			"fifo_queue": {
 				Type:        schema.TypeBool,
 				Description: "Is this queue [FIFO](https://yandex.cloud/docs/message-queue/concepts/queue#fifo-queues). If this parameter is not used, a standard queue is created. You cannot change the parameter value for a created queue.",
@@ -233,6 +259,10 @@ func resourceYandexMessageQueueCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if err := expandMessageQueueRedriveAttributes(d, attributes, false); err != nil {
+		return err
+	}
+
 	if len(attributes) > 0 {
 		req.Attributes = attributes
 	}
@@ -337,6 +367,10 @@ func resourceYandexMessageQueueUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if err := expandMessageQueueRedriveAttributes(d, attributes, true); err != nil {
+		return err
+	}
+
 	if len(attributes) > 0 {
 		log.Printf("[INFO] Setting new messsage queue attributes for queue %s", d.Id())
 
@@ -467,6 +501,9 @@ func resourceYandexMessageQueueReadImpl(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
+	// the deprecated JSON string is kept in the state only while it's used in the configuration
+	useRedrivePolicyJSON := d.Get("redrive_policy").(string) != ""
+
 	// Always set attribute defaults
 	d.Set("arn", "")
 	d.Set("content_based_deduplication", false)
@@ -477,6 +514,7 @@ func resourceYandexMessageQueueReadImpl(d *schema.ResourceData, meta interface{}
 	d.Set("name", name)
 	d.Set("receive_wait_time_seconds", 0)
 	d.Set("redrive_policy", "")
+	d.Set("redrive", nil)
 	d.Set("visibility_timeout_seconds", 30)
 	d.Set("region_id", defaultYMQRegion)
 
@@ -576,8 +614,18 @@ func resourceYandexMessageQueueReadImpl(d *schema.ResourceData, meta interface{}
 			d.Set("receive_wait_time_seconds", vInt)
 		}
 
-		if v, ok := queueAttributes[sqs.QueueAttributeNameRedrivePolicy]; ok {
-			d.Set("redrive_policy", v)
+		if v, ok := queueAttributes[sqs.QueueAttributeNameRedrivePolicy]; ok && v != "" {
+			if useRedrivePolicyJSON {
+				d.Set("redrive_policy", v)
+			} else {
+				redrivePolicy, err := flattenMessageQueueRedrivePolicy(v)
+				if err != nil {
+					return err
+				}
+				if err := d.Set("redrive", redrivePolicy); err != nil {
+					return err
+				}
+			}
 		}
 
 		if v, ok := queueAttributes[sqs.QueueAttributeNameVisibilityTimeout]; ok && v != "" {
@@ -593,6 +641,102 @@ func resourceYandexMessageQueueReadImpl(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+type messageQueueRedrivePolicy struct {
+	DeadLetterTargetArn string      `json:"deadLetterTargetArn"`
+	MaxReceiveCount     json.Number `json:"maxReceiveCount"`
+}
+
+func resourceYandexMessageQueueCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// the ARN of the Dead Letter Queue is empty while it's unknown, it's checked once more when the queue is applied
+	arn := diff.Get("redrive.0.dead_letter_target_arn").(string)
+	if v := diff.Get("redrive_policy").(string); v != "" {
+		// an invalid JSON string is reported by the validation of redrive_policy
+		if policy, err := flattenMessageQueueRedrivePolicy(v); err == nil {
+			arn = policy[0]["dead_letter_target_arn"].(string)
+		}
+	}
+	if arn != "" {
+		return validateMessageQueueDeadLetterTarget(diff.Get("fifo_queue").(bool), arn)
+	}
+	return nil
+}
+
+// validateMessageQueueDeadLetterTarget checks that the Dead Letter Queue has the same type as the source queue.
+func validateMessageQueueDeadLetterTarget(fifo bool, deadLetterTargetArn string) error {
+	// yrn:yc:ymq:ru-central1:21i6v06sqmsaoeon7nus:event-queue
+	deadLetterFifo := strings.HasSuffix(deadLetterTargetArn[strings.LastIndex(deadLetterTargetArn, ":")+1:], ".fifo")
+	if fifo && !deadLetterFifo {
+		return fmt.Errorf("Dead Letter Queue %s of a FIFO queue must also be a FIFO queue", deadLetterTargetArn)
+	}
+	if !fifo && deadLetterFifo {
+		return fmt.Errorf("Dead Letter Queue %s of a standard queue must also be a standard queue", deadLetterTargetArn)
+	}
+	return nil
+}
+
+// expandMessageQueueRedriveAttributes adds the redrive policy of the queue to the attributes of the request,
+// either from the deprecated redrive_policy JSON string or from the redrive block.
+// On update the policy is only added if it's changed, and a removed policy is reset with an empty value.
+func expandMessageQueueRedriveAttributes(d *schema.ResourceData, attributes map[string]*string, update bool) error {
+	if update && !d.HasChange("redrive_policy") && !d.HasChange("redrive") {
+		return nil
+	}
+
+	policy := d.Get("redrive_policy").(string)
+	if policy == "" {
+		var err error
+		if policy, err = expandMessageQueueRedrivePolicy(d.Get("redrive").([]interface{})); err != nil {
+			return err
+		}
+	}
+	if policy != "" {
+		redrivePolicy, err := flattenMessageQueueRedrivePolicy(policy)
+		if err != nil {
+			return err
+		}
+		if err := validateMessageQueueDeadLetterTarget(d.Get("fifo_queue").(bool), redrivePolicy[0]["dead_letter_target_arn"].(string)); err != nil {
+			return err
+		}
+	}
+	if policy != "" || update {
+		attributes[sqs.QueueAttributeNameRedrivePolicy] = aws.String(policy)
+	}
+	return nil
+}
+
+func expandMessageQueueRedrivePolicy(v []interface{}) (string, error) {
+	if len(v) == 0 || v[0] == nil {
+		return "", nil
+	}
+	policy := v[0].(map[string]interface{})
+
+	bytes, err := json.Marshal(messageQueueRedrivePolicy{
+		DeadLetterTargetArn: policy["dead_letter_target_arn"].(string),
+		MaxReceiveCount:     json.Number(strconv.Itoa(policy["max_receive_count"].(int))),
+	})
+	if err != nil {
+		return "", fmt.Errorf("Error marshalling redrive_policy: %s", err)
+	}
+	return string(bytes), nil
+}
+
+func flattenMessageQueueRedrivePolicy(v string) ([]map[string]interface{}, error) {
+	var policy messageQueueRedrivePolicy
+	if err := json.Unmarshal([]byte(v), &policy); err != nil {
+		return nil, fmt.Errorf("Error parsing redrive_policy value (%s): %s", v, err)
+	}
+
+	// maxReceiveCount is either a number or a string with a number, both are decoded into json.Number
+	maxReceiveCount, err := strconv.Atoi(policy.MaxReceiveCount.String())
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing maxReceiveCount value (%s) of redrive_policy into integer: %s", policy.MaxReceiveCount, err)
+	}
+	return []map[string]interface{}{{
+		"dead_letter_target_arn": policy.DeadLetterTargetArn,
+		"max_receive_count":      maxReceiveCount,
+	}}, nil
+}
+
 func extractNameFromQueueUrl(queue string) (string, error) {
 	// Example: https://message-queue.api.cloud.yandex.net/b1g8ad42m6he1ooql78r/dj6000000000qq9v07ol/yet-another-queue
 	u, err := url.Parse(queue)
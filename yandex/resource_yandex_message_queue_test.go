@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
 	"os"
+	"reflect"
 	"regexp"
 	"testing"
 	"time"
@@ -171,12 +172,109 @@ func TestAccMessageQueue_redrivePolicy(t *testing.T) {
 					testAccCheckMessageQueueDefaultAttributes(&queueAttributes),
 					testAccCheckMessageQueueExists("yandex_message_queue.queue", &redriverQueueAttributes),
 					testAccCheckMessageQueueRedriverAttributes(&redriverQueueAttributes, &queueAttributes),
+					resource.TestCheckResourceAttr("yandex_message_queue.queue", "redrive.0.max_receive_count", "3"),
+					resource.TestCheckResourceAttrPair("yandex_message_queue.queue", "redrive.0.dead_letter_target_arn", "yandex_message_queue.dead_letter_queue", "arn"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccMessageQueue_redrivePolicyJSON(t *testing.T) {
+	var queueAttributes map[string]*string
+	var redriverQueueAttributes map[string]*string
+
+	var randInt int = acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMessageQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMessageQueueConfigWithRedrivePolicyJSON(randInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMessageQueueExists("yandex_message_queue.dead_letter_queue", &queueAttributes),
+					testAccCheckMessageQueueExists("yandex_message_queue.queue", &redriverQueueAttributes),
+					testAccCheckMessageQueueRedriverAttributes(&redriverQueueAttributes, &queueAttributes),
+					resource.TestCheckResourceAttr("yandex_message_queue.queue", "redrive.#", "0"),
+				),
+			},
+			{
+				// the deprecated JSON string is replaced with the block
+				Config: testAccMessageQueueConfigWithRedrive(randInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMessageQueueExists("yandex_message_queue.queue", &redriverQueueAttributes),
+					testAccCheckMessageQueueRedriverAttributes(&redriverQueueAttributes, &queueAttributes),
+					resource.TestCheckResourceAttr("yandex_message_queue.queue", "redrive_policy", ""),
+					resource.TestCheckResourceAttr("yandex_message_queue.queue", "redrive.0.max_receive_count", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMessageQueue_redrivePolicyTypeMismatch(t *testing.T) {
+	var randInt int = acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMessageQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMessageQueueConfigWithRedriveTypeMismatch(randInt),
+				ExpectError: regexp.MustCompile("must also be a FIFO queue"),
+			},
+		},
+	})
+}
+
+func TestMessageQueueRedrivePolicy(t *testing.T) {
+	policy, err := expandMessageQueueRedrivePolicy([]interface{}{map[string]interface{}{
+		"dead_letter_target_arn": "yrn:yc:ymq:ru-central1:b1gexample:dlq",
+		"max_receive_count":      3,
+	}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if expected := `{"deadLetterTargetArn":"yrn:yc:ymq:ru-central1:b1gexample:dlq","maxReceiveCount":3}`; policy != expected {
+		t.Fatalf("Unexpected redrive policy.\nExpected: %s\nActual: %s", expected, policy)
+	}
+
+	for _, v := range []string{policy, `{"deadLetterTargetArn":"yrn:yc:ymq:ru-central1:b1gexample:dlq","maxReceiveCount":"3"}`} {
+		flattened, err := flattenMessageQueueRedrivePolicy(v)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		expected := []map[string]interface{}{{"dead_letter_target_arn": "yrn:yc:ymq:ru-central1:b1gexample:dlq", "max_receive_count": 3}}
+		if !reflect.DeepEqual(expected, flattened) {
+			t.Fatalf("Unexpected redrive policy of %s.\nExpected: %#v\nActual: %#v", v, expected, flattened)
+		}
+	}
+
+	if policy, _ := expandMessageQueueRedrivePolicy(nil); policy != "" {
+		t.Fatalf("Expected empty redrive policy, got %s", policy)
+	}
+}
+
+func TestValidateMessageQueueDeadLetterTarget(t *testing.T) {
+	cases := []struct {
+		fifo    bool
+		arn     string
+		wantErr bool
+	}{
+		{fifo: false, arn: "yrn:yc:ymq:ru-central1:b1gexample:dlq"},
+		{fifo: true, arn: "yrn:yc:ymq:ru-central1:b1gexample:dlq.fifo"},
+		{fifo: true, arn: "yrn:yc:ymq:ru-central1:b1gexample:dlq", wantErr: true},
+		{fifo: false, arn: "yrn:yc:ymq:ru-central1:b1gexample:dlq.fifo", wantErr: true},
+	}
+	for _, c := range cases {
+		err := validateMessageQueueDeadLetterTarget(c.fifo, c.arn)
+		if (err != nil) != c.wantErr {
+			t.Errorf("validateMessageQueueDeadLetterTarget(%t, %s) returned %v, want error: %t", c.fifo, c.arn, err, c.wantErr)
+		}
+	}
+}
+
 func TestAccMessageQueue_FIFO(t *testing.T) {
 	var queueAttributes map[string]*string
 
@@ -699,6 +797,31 @@ resource "yandex_message_queue" "queue" {
   access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
   secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
 
+  redrive {
+    dead_letter_target_arn = yandex_message_queue.dead_letter_queue.arn
+    max_receive_count      = 3
+  }
+}
+
+resource "yandex_message_queue" "dead_letter_queue" {
+  name = "tfotherqueuq-%d"
+
+  access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+  secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+}
+`, randInt, randInt) + testAccCommonIamDependenciesEditorConfig(randInt)
+}
+
+func testAccMessageQueueConfigWithRedrivePolicyJSON(randInt int) string {
+	return fmt.Sprintf(`
+resource "yandex_message_queue" "queue" {
+  name                       = "tftestqueuq-%d"
+  delay_seconds              = 0
+  visibility_timeout_seconds = 300
+
+  access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+  secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+
   redrive_policy = <<EOF
 {
   "maxReceiveCount": 3,
@@ -716,6 +839,20 @@ resource "yandex_message_queue" "dead_letter_queue" {
 `, randInt, randInt) + testAccCommonIamDependenciesEditorConfig(randInt)
 }
 
+func testAccMessageQueueConfigWithRedriveTypeMismatch(randInt int) string {
+	return fmt.Sprintf(`
+resource "yandex_message_queue" "queue" {
+  name       = "tftestqueuq-%d.fifo"
+  fifo_queue = true
+
+  redrive {
+    dead_letter_target_arn = "yrn:yc:ymq:ru-central1:b1gexample:tfotherqueuq-%d"
+    max_receive_count      = 3
+  }
+}
+`, randInt, randInt)
+}
+
 func testAccMessageQueueConfigWithFIFO(randInt int) string {
 	return fmt.Sprintf(`
 resource "yandex_message_queue" "queue" {
@@ -12,6 +12,7 @@ import (
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 const yandexMonitoringDashboardDefaultTimeout = 2 * time.Minute
@@ -38,6 +39,14 @@ func resourceYandexMonitoringDashboard() *schema.Resource {
 		},
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
+			"config_json": {
+				Type:             schema.TypeString,
+				Description:      "Widgets and parametrization of the dashboard as a JSON document, e.g. exported from the console or by the `yandex_monitoring_dashboard` data source. Other fields of the document are ignored. Documents that differ only in formatting, key order and chart IDs are considered equal. Conflicts with `widgets` and `parametrization`.",
+				Optional:         true,
+				ValidateFunc:     validateDashboardConfigJSON,
+				DiffSuppressFunc: suppressDashboardConfigJSONDiff,
+				ConflictsWith:    []string{"widgets", "parametrization"},
+			},
 			"dashboard_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -193,8 +202,9 @@ func resourceYandexMonitoringDashboard() *schema.Resource {
 						},
 					},
 				},
-				Optional: true,
-				Computed: true,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"config_json"},
 			},
 			"title": {
 				Type:        schema.TypeString,
@@ -714,7 +724,8 @@ func resourceYandexMonitoringDashboard() *schema.Resource {
 						},
 					},
 				},
-				Optional: true,
+				Optional:      true,
+				ConflictsWith: []string{"config_json"},
 			},
 		},
 	}
@@ -731,14 +742,10 @@ func resourceMonitoringDashboardCreate(ctx context.Context, d *schema.ResourceDa
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Error expanding labels while creating dashboard: %s", err))
 	}
-	widgets, err := expandDashboardWidgetsSlice(d)
+	widgets, parametrization, err := expandDashboardWidgetsAndParametrization(d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Error expanding widgets while creating dashboard: %s", err))
 	}
-	parametrization, err := expandDashboardParametrization(d)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("Error expanding parametrization while creating dashboard: %s", err))
-	}
 
 	req := &monitoring.CreateDashboardRequest{
 		Name: d.Get("name").(string),
@@ -827,6 +834,18 @@ func resourceMonitoringDashboardRead(ctxParent context.Context, d *schema.Resour
 		}
 		return diag.FromErr(err)
 	}
+	if _, ok := d.GetOk("config_json"); ok {
+		configJSON, err := flattenDashboardConfigJSON(dashboard.GetWidgets(), dashboard.GetParametrization())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("config_json", configJSON)
+
+		// widgets and parametrization are managed by config_json, don't duplicate them in the state
+		dashboard = proto.Clone(dashboard).(*monitoring.Dashboard)
+		dashboard.Widgets = nil
+		dashboard.Parametrization = nil
+	}
 	err = monitoringDashboardToState(dashboard, d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -834,6 +853,23 @@ func resourceMonitoringDashboardRead(ctxParent context.Context, d *schema.Resour
 	return nil
 }
 
+// expandDashboardWidgetsAndParametrization takes widgets and parametrization either from config_json or from the blocks.
+func expandDashboardWidgetsAndParametrization(d *schema.ResourceData) ([]*monitoring.Widget, *monitoring.Parametrization, error) {
+	if v, ok := d.GetOk("config_json"); ok {
+		return expandDashboardConfigJSON(v.(string))
+	}
+
+	widgets, err := expandDashboardWidgetsSlice(d)
+	if err != nil {
+		return nil, nil, err
+	}
+	parametrization, err := expandDashboardParametrization(d)
+	if err != nil {
+		return nil, nil, err
+	}
+	return widgets, parametrization, nil
+}
+
 func resourceMonitoringDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
@@ -841,14 +877,10 @@ func resourceMonitoringDashboardUpdate(ctx context.Context, d *schema.ResourceDa
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Error expanding labels while updating dashboard: %s", err))
 	}
-	widgets, err := expandDashboardWidgetsSlice(d)
+	widgets, parametrization, err := expandDashboardWidgetsAndParametrization(d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Error expanding widgets while updating dashboard: %s", err))
 	}
-	parametrization, err := expandDashboardParametrization(d)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("Error expanding parametrization while updating dashboard: %s", err))
-	}
 
 	req := &monitoring.UpdateDashboardRequest{
 		DashboardId:     d.Id(),
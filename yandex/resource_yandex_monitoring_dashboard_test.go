@@ -83,6 +83,68 @@ func TestAccResourceMonitoringDashboard(t *testing.T) {
 	})
 }
 
+func TestAccResourceMonitoringDashboard_configJSON(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMonitoringDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMonitoringDashboardConfigJSON("text here"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccResourceMonitoringDashboardExists(),
+					resource.TestCheckResourceAttr(monitoringDashboardResource, "widgets.#", "0"),
+					resource.TestCheckResourceAttr(monitoringDashboardResource, "parametrization.#", "0"),
+					resource.TestCheckResourceAttrSet(monitoringDashboardResource, "config_json"),
+				),
+			},
+			{
+				Config: testAccResourceMonitoringDashboardConfigJSON("other text"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccResourceMonitoringDashboardExists(),
+					resource.TestCheckResourceAttrSet(monitoringDashboardResource, "config_json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceMonitoringDashboardConfigJSON(text string) string {
+	return fmt.Sprintf(`
+	resource "yandex_monitoring_dashboard" "this" {
+	  name  = "local-id-resource-json"
+	  title = "My title"
+	  config_json = jsonencode({
+		widgets = [
+		  {
+			position = { x = 0, y = 0, w = 6, h = 1 }
+			text     = { text = "%s" }
+		  },
+		  {
+			position = { x = 0, y = 1, w = 6, h = 4 }
+			chart = {
+			  title   = "chart"
+			  queries = { targets = [{ query = "{service=monitoring}", textMode = true }] }
+			}
+		  },
+		]
+		parametrization = {
+		  selectors = "a=b"
+		  parameters = [
+			{
+			  id     = "param1"
+			  title  = "title"
+			  custom = { values = ["1", "2", "3"], defaultValues = ["1"] }
+			},
+		  ]
+		}
+	  })
+	}
+`, text)
+}
+
 func checkResourceMonitoringDashboardStep(description string) resource.TestCheckFunc {
 	return resource.ComposeTestCheckFunc(
 		testAccResourceMonitoringDashboardExists(),
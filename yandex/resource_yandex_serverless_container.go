@@ -2,6 +2,7 @@ package yandex
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -410,6 +411,45 @@ func resourceYandexServerlessContainer() *schema.Resource {
 				},
 			},
 
+			"rollout": {
+				Type: schema.TypeList,
+				Description: "Health check of the revisions deployed on update. After a new revision is deployed, `query` is checked until `observation_period` is over, " +
+					"and the container is rolled back to the previous revision as soon as a value of `query` exceeds `threshold`. " +
+					"Transient errors of Monitoring are retried; if `query` still can't be read, the new revision is kept with a warning. " +
+					"The container routes all traffic to its latest revision, so traffic can't be split between revisions; use the `canary` block of `yandex_api_gateway` for that. " +
+					"The update timeout must be longer than `observation_period`.",
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"query": {
+							Type:        schema.TypeString,
+							Description: "[Monitoring query](https://yandex.cloud/docs/monitoring/concepts/querying) of the container health, e.g. its error rate, evaluated in the folder of the container.",
+							Required:    true,
+						},
+						"threshold": {
+							Type:        schema.TypeFloat,
+							Description: "Maximum allowed value of `query`.",
+							Required:    true,
+						},
+						"observation_period": {
+							Type:         schema.TypeString,
+							Description:  "Period after the deployment to check `query` for. The default is `3m`.",
+							Optional:     true,
+							Default:      "3m",
+							ValidateFunc: validateParsableValue(parseDuration),
+						},
+						"check_interval": {
+							Type:         schema.TypeString,
+							Description:  "Interval between checks of `query`. The default is `30s`.",
+							Optional:     true,
+							Default:      "30s",
+							ValidateFunc: validateParsableValue(parseDuration),
+						},
+					},
+				},
+			},
+
 			"async_invocation": {
 				Type:        schema.TypeList,
 				Description: "Config for asynchronous invocations of Yandex Cloud Serverless Container.",
@@ -645,9 +685,24 @@ func resourceYandexServerlessContainerUpdate(ctx context.Context, d *schema.Reso
 	var diags diag.Diagnostics
 	if revisionReq != nil {
 		revisionReq.ContainerId = d.Id()
-		diags = resourceYandexServerlessContainerDiagsFromDeployRevisionError(
-			resourceYandexServerlessContainerDeployRevision(ctx, config.sdk, revisionReq),
-		)
+		previousRevisionID := d.Get("revision_id").(string)
+		deployedAt := time.Now()
+		err := resourceYandexServerlessContainerDeployRevision(ctx, config.sdk, revisionReq)
+		diags = resourceYandexServerlessContainerDiagsFromDeployRevisionError(err)
+		if err == nil && previousRevisionID != "" {
+			if err := checkServerlessContainerRollout(ctx, config, d, deployedAt); err != nil {
+				var exceeded *rolloutThresholdExceededError
+				if errors.As(err, &exceeded) {
+					// the state keeps the previous revision, so that the next apply deploys the revision again
+					return resourceYandexServerlessContainerRollback(ctx, config, d.Id(), previousRevisionID, err)
+				}
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to check rollout of Yandex Cloud Container",
+					Detail:   "The deployed revision is kept, since the rollout query couldn't be checked: " + err.Error(),
+				})
+			}
+		}
 	}
 	d.Partial(false)
 
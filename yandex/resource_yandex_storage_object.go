@@ -2,10 +2,13 @@ package yandex
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
+	"fmt"
 	"log"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/storage/s3"
 
@@ -22,6 +25,10 @@ func resourceYandexStorageObject() *schema.Resource {
 		UpdateContext: resourceYandexStorageObjectUpdate,
 		DeleteContext: resourceYandexStorageObjectDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexStorageObjectImportState,
+		},
+
 		SchemaVersion: 0,
 
 		Schema: map[string]*schema.Schema{
@@ -227,6 +234,52 @@ func resourceYandexStorageObjectRead(ctx context.Context, d *schema.ResourceData
 	return nil
 }
 
+// storageObjectImportMaxContentSize is the size of the largest object whose content is imported.
+const storageObjectImportMaxContentSize = 1 << 20
+
+// resourceYandexStorageObjectImportState imports the object by the {bucket}/{key} identifier.
+// The content of small objects is imported into content, or into content_base64 if it's not UTF-8 text.
+func resourceYandexStorageObjectImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	bucket, key, ok := strings.Cut(d.Id(), "/")
+	if !ok || bucket == "" || key == "" {
+		return nil, fmt.Errorf("invalid storage object specifier %q, expecting {bucket}/{key}", d.Id())
+	}
+
+	d.SetId(key)
+	d.Set("bucket", bucket)
+	d.Set("key", key)
+
+	s3Client, err := getS3Client(ctx, d, meta.(*Config))
+	if err != nil {
+		return nil, fmt.Errorf("error getting storage client: %s", err)
+	}
+
+	acl, err := s3Client.GetObjectACL(ctx, bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	if acl == "" {
+		log.Printf("[WARN] ACL of object %q in bucket %q doesn't match any predefined ACL, assuming %q", key, bucket, "private")
+		acl = "private"
+	}
+	d.Set("acl", acl)
+
+	content, err := s3Client.GetObjectContent(ctx, bucket, key, storageObjectImportMaxContentSize)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case content == nil:
+		log.Printf("[WARN] Object %q in bucket %q is larger than %d bytes, its content is not imported", key, bucket, storageObjectImportMaxContentSize)
+	case utf8.Valid(content):
+		d.Set("content", string(content))
+	default:
+		d.Set("content_base64", base64.StdEncoding.EncodeToString(content))
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceYandexStorageObjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if hasObjectContentChanged(d) {
 		return resourceYandexStorageObjectCreate(ctx, d, meta)
@@ -161,7 +161,7 @@ func TestAccStorageObject_content(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
 		CheckDestroy:             testAccCheckStorageObjectDestroy,
-		Steps: []resource.TestStep{
+		Steps: append([]resource.TestStep{
 			{
 				Config: testAccStorageObjectConfigContent(rInt, "some_bucket_content"),
 				Check: resource.ComposeTestCheckFunc(
@@ -169,10 +169,36 @@ func TestAccStorageObject_content(t *testing.T) {
 					testAccCheckStorageObjectBody(&obj, "some_bucket_content"),
 				),
 			},
-		},
+		}, storageObjectImportSteps(resourceName, testAccStorageObjectConfigContent(rInt, "some_bucket_content"))...),
 	})
 }
 
+// storageObjectImportSteps import the object and check that the imported state matches the configuration,
+// i.e. that the plan after the import is empty.
+func storageObjectImportSteps(resourceName string, config string) []resource.TestStep {
+	return []resource.TestStep{
+		{
+			ResourceName:       resourceName,
+			ImportState:        true,
+			ImportStateVerify:  true,
+			ImportStatePersist: true,
+			ImportStateIdFunc: func(s *terraform.State) (string, error) {
+				rs, ok := s.RootModule().Resources[resourceName]
+				if !ok {
+					return "", fmt.Errorf("Not found: %s", resourceName)
+				}
+				return rs.Primary.Attributes["bucket"] + "/" + rs.Primary.ID, nil
+			},
+			// keys used to apply changes are not imported
+			ImportStateVerifyIgnore: []string{"access_key", "secret_key"},
+		},
+		{
+			Config:   config,
+			PlanOnly: true,
+		},
+	}
+}
+
 func TestAccStorageObject_contentBase64(t *testing.T) {
 	var obj awsS3.GetObjectOutput
 	resourceName := "yandex_storage_object.test"
@@ -244,26 +270,29 @@ func TestAccStorageObject_updateAcl(t *testing.T) {
 	rInt := acctest.RandInt()
 	resourceName := "yandex_storage_object.test"
 
+	steps := []resource.TestStep{
+		{
+			Config: testAccStorageObjectAclPreConfig(rInt),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckStorageObjectExists(resourceName, &obj),
+				resource.TestCheckResourceAttr(resourceName, "acl", "public-read"),
+			),
+		},
+	}
+	steps = append(steps, storageObjectImportSteps(resourceName, testAccStorageObjectAclPreConfig(rInt))...)
+	steps = append(steps, resource.TestStep{
+		Config: testAccStorageObjectAclPostConfig(rInt),
+		Check: resource.ComposeTestCheckFunc(
+			testAccCheckStorageObjectExists(resourceName, &obj),
+			resource.TestCheckResourceAttr(resourceName, "acl", "private"),
+		),
+	})
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
 		CheckDestroy:             testAccCheckStorageObjectDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccStorageObjectAclPreConfig(rInt),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckStorageObjectExists(resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "acl", "public-read"),
-				),
-			},
-			{
-				Config: testAccStorageObjectAclPostConfig(rInt),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckStorageObjectExists(resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "acl", "private"),
-				),
-			},
-		},
+		Steps:                    steps,
 	})
 }
 
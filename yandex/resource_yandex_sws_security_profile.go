@@ -37,6 +37,8 @@ func resourceYandexSmartwebsecuritySecurityProfile() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceYandexSmartwebsecuritySecurityProfileCustomizeDiff,
+
 		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
@@ -128,6 +130,8 @@ func resourceYandexSmartwebsecuritySecurityProfile() *schema.Resource {
 				ValidateFunc: validation.All(validation.StringMatch(regexp.MustCompile("^([a-zA-Z0-9][a-zA-Z0-9-_.]*)$"), ""), validation.StringLenBetween(1, 50)),
 			},
 
+			"rule_bundle": swsRuleBundleSchema(),
+
 			"security_rule": {
 				Type:        schema.TypeList,
 				Description: "List of security rules.\n\n~> Exactly one rule specifier: `smart_protection` or `rule_condition` or `waf` should be specified.\n",
@@ -1491,6 +1495,16 @@ func resourceYandexSmartwebsecuritySecurityProfileCreate(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
+	ruleBundles, err := expandSWSRuleBundles(d.Get("rule_bundle").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	securityRules, err = mergeSWSRuleBundles(securityRules, ruleBundles)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	analyzeRequestBody, err := expandSecurityProfileAnalyzeRequestBody(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -1573,7 +1587,12 @@ func resourceYandexSmartwebsecuritySecurityProfileRead(ctx context.Context, d *s
 
 	createdAt := getTimestamp(resp.GetCreatedAt())
 
-	securityRule, err := flattenSmartwebsecuritySecurityRuleSlice(resp.GetSecurityRules())
+	ownSecurityRules, ruleBundles, err := splitSWSRuleBundles(resp.GetSecurityRules(), d.Get("rule_bundle").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	securityRule, err := flattenSmartwebsecuritySecurityRuleSlice(ownSecurityRules)
 	if err != nil { // isElem: false, ret: 1
 		return diag.FromErr(err)
 	}
@@ -1618,6 +1637,10 @@ func resourceYandexSmartwebsecuritySecurityProfileRead(ctx context.Context, d *s
 		log.Printf("[ERROR] failed set field name: %s", err)
 		return diag.FromErr(err)
 	}
+	if err := d.Set("rule_bundle", ruleBundles); err != nil {
+		log.Printf("[ERROR] failed set field rule_bundle: %s", err)
+		return diag.FromErr(err)
+	}
 	if err := d.Set("security_rule", securityRule); err != nil {
 		log.Printf("[ERROR] failed set field security_rule: %s", err)
 		return diag.FromErr(err)
@@ -1640,6 +1663,16 @@ func resourceYandexSmartwebsecuritySecurityProfileUpdate(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
+	ruleBundles, err := expandSWSRuleBundles(d.Get("rule_bundle").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	securityRules, err = mergeSWSRuleBundles(securityRules, ruleBundles)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	analyzeRequestBody, err := expandSecurityProfileAnalyzeRequestBody_(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -1711,12 +1744,40 @@ func resourceYandexSmartwebsecuritySecurityProfileDelete(ctx context.Context, d
 	return nil
 }
 
+// resourceYandexSmartwebsecuritySecurityProfileCustomizeDiff validates the rules of the profile merged with the rule bundles,
+// so that colliding priorities and names are reported at plan time. The rules with unknown values are skipped.
+func resourceYandexSmartwebsecuritySecurityProfileCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	var securityRules []*smartwebsecurity.SecurityRule
+	for i, raw := range diff.Get("security_rule").([]interface{}) {
+		m, ok := raw.(map[string]interface{})
+		if !ok || !diff.NewValueKnown(fmt.Sprintf("security_rule.%d.name", i)) || !diff.NewValueKnown(fmt.Sprintf("security_rule.%d.priority", i)) {
+			continue
+		}
+		securityRules = append(securityRules, swsSecurityRuleFromMap(m))
+	}
+
+	var knownBundles []interface{}
+	for i, raw := range diff.Get("rule_bundle").([]interface{}) {
+		if diff.NewValueKnown(fmt.Sprintf("rule_bundle.%d.rules_json", i)) && diff.NewValueKnown(fmt.Sprintf("rule_bundle.%d.priority_offset", i)) {
+			knownBundles = append(knownBundles, raw)
+		}
+	}
+	ruleBundles, err := expandSWSRuleBundles(knownBundles)
+	if err != nil {
+		return err
+	}
+
+	_, err = mergeSWSRuleBundles(securityRules, ruleBundles)
+	return err
+}
+
 var resourceYandexSmartwebsecuritySecurityProfileUpdateFieldsMap = map[string]string{
 	"labels":                            "labels",
 	"name":                              "name",
 	"description":                       "description",
 	"default_action":                    "default_action",
 	"security_rule":                     "security_rules",
+	"rule_bundle":                       "security_rules",
 	"captcha_id":                        "captcha_id",
 	"advanced_rate_limiter_profile_id":  "advanced_rate_limiter_profile_id",
 	"analyze_request_body.0.size_limit": "analyze_request_body.size_limit",
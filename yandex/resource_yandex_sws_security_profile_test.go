@@ -2,6 +2,7 @@ package yandex
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -68,6 +69,98 @@ resource "yandex_smartcaptcha_captcha" "this" {
 `, targetName)
 }
 
+func TestAccSmartwebsecuritySecurityProfile_ruleBundle(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-yc-sc")
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSmartwebsecuritySecurityProfileRuleBundle(name, 1000, 1010),
+				ExpectError: regexp.MustCompile("have the same priority 1010"),
+				PlanOnly:    true,
+			},
+			{
+				Config: testAccSmartwebsecuritySecurityProfileRuleBundle(name, 1000, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_sws_security_profile.this", "security_rule.#", "1"),
+					resource.TestCheckResourceAttr("yandex_sws_security_profile.this", "security_rule.0.name", "allow-office"),
+					resource.TestCheckResourceAttr("yandex_sws_security_profile.this", "rule_bundle.#", "1"),
+					resource.TestCheckResourceAttrPair("yandex_sws_security_profile.this", "rule_bundle.0.rules_json", "data.yandex_sws_rule_bundle.common", "rules_json"),
+					resource.TestCheckResourceAttr("yandex_sws_security_profile.this", "rule_bundle.0.priority_offset", "1000"),
+				),
+			},
+			{
+				Config: testAccSmartwebsecuritySecurityProfileRuleBundle(name, 2000, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_sws_security_profile.this", "rule_bundle.0.priority_offset", "2000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSmartwebsecuritySecurityProfileRuleBundle(targetName string, priorityOffset, ownPriority int) string {
+	return fmt.Sprintf(`
+data "yandex_sws_rule_bundle" "common" {
+  name = "common"
+  security_rule {
+    name     = "deny-tor"
+    priority = 10
+    rule_condition {
+      action = "DENY"
+      condition {
+        source_ip {
+          geo_ip_match {
+            locations = ["tor"]
+          }
+        }
+      }
+    }
+  }
+  security_rule {
+    name     = "deny-private"
+    priority = 20
+    rule_condition {
+      action = "DENY"
+      condition {
+        source_ip {
+          ip_ranges_match {
+            ip_ranges = ["10.0.0.0/8"]
+          }
+        }
+      }
+    }
+  }
+}
+
+resource "yandex_sws_security_profile" "this" {
+  name           = "%[1]v"
+  default_action = "ALLOW"
+
+  security_rule {
+    name     = "allow-office"
+    priority = %[3]d
+    rule_condition {
+      action = "ALLOW"
+      condition {
+        source_ip {
+          ip_ranges_match {
+            ip_ranges = ["192.168.1.0/24"]
+          }
+        }
+      }
+    }
+  }
+
+  rule_bundle {
+    rules_json      = data.yandex_sws_rule_bundle.common.rules_json
+    priority_offset = %[2]d
+  }
+}
+`, targetName, priorityOffset, ownPriority)
+}
+
 func testSweepSecurityProfile(_ string) error {
 	conf, err := configForSweepers()
 	if err != nil {
@@ -0,0 +1,43 @@
+package yandex
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/coordination"
+)
+
+func resourceYandexYDBCoordinationNode() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a coordination node of a YDB database. Coordination nodes are used for distributed locks, leader election and service discovery. For more information, see [the official documentation](https://yandex.cloud/docs/ydb/concepts/#ydb).",
+
+		Schema:        coordination.ResourceSchema(),
+		SchemaVersion: 0,
+		CreateContext: resourceYandexYDBCoordinationNodeCreate,
+		ReadContext:   resourceYandexYDBCoordinationNodeRead,
+		UpdateContext: resourceYandexYDBCoordinationNodeUpdate,
+		DeleteContext: resourceYandexYDBCoordinationNodeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: ydbTimeouts(),
+	}
+}
+
+func resourceYandexYDBCoordinationNodeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return coordination.ResourceCreateFunc(ydbIAMAuthCallback(meta))(ctx, d, meta)
+}
+
+func resourceYandexYDBCoordinationNodeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return coordination.ResourceReadFunc(ydbIAMAuthCallback(meta))(ctx, d, meta)
+}
+
+func resourceYandexYDBCoordinationNodeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return coordination.ResourceUpdateFunc(ydbIAMAuthCallback(meta))(ctx, d, meta)
+}
+
+func resourceYandexYDBCoordinationNodeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return coordination.ResourceDeleteFunc(ydbIAMAuthCallback(meta))(ctx, d, meta)
+}
@@ -0,0 +1,549 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceYandexYDBDocumentTable() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a Document API table of a serverless YDB database. The Document API is compatible with Amazon DynamoDB. For more information, see [the official documentation](https://yandex.cloud/docs/ydb/docapi/tools/aws-setup).\n\n" +
+			"The requests are authenticated with the static access keys of a service account if they are specified, and with the IAM token of the provider otherwise.\n",
+
+		Schema: map[string]*schema.Schema{
+			"document_api_endpoint": {
+				Type:        schema.TypeString,
+				Description: "Document API endpoint of the database, e.g. the `document_api_endpoint` attribute of `yandex_ydb_database_serverless`.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "Name of the table. It can contain the path of the table relative to the database root, e.g. `dir/table`.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"hash_key": {
+				Type:        schema.TypeString,
+				Description: "Name of the attribute used as the partition (hash) key of the table.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"range_key": {
+				Type:        schema.TypeString,
+				Description: "Name of the attribute used as the sort (range) key of the table.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"attribute": {
+				Type:        schema.TypeSet,
+				Description: "Definitions of the attributes used as keys of the table and of its indexes. The definitions of an existing table can only be changed along with `global_secondary_index`, a change of them alone is an error.",
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the attribute.",
+							Required:    true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Description:  "Type of the attribute: `S` for strings, `N` for numbers or `B` for binary data.",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.ScalarAttributeType_Values(), false),
+						},
+					},
+				},
+			},
+			"global_secondary_index": {
+				Type:        schema.TypeSet,
+				Description: "Global secondary indexes of the table. A changed index is deleted and created again.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the index.",
+							Required:    true,
+						},
+						"hash_key": {
+							Type:        schema.TypeString,
+							Description: "Name of the attribute used as the partition (hash) key of the index.",
+							Required:    true,
+						},
+						"range_key": {
+							Type:        schema.TypeString,
+							Description: "Name of the attribute used as the sort (range) key of the index.",
+							Optional:    true,
+						},
+						"projection_type": {
+							Type:         schema.TypeString,
+							Description:  "Attributes copied to the index: `ALL`, `KEYS_ONLY` or `INCLUDE`.",
+							Optional:     true,
+							Default:      dynamodb.ProjectionTypeAll,
+							ValidateFunc: validation.StringInSlice(dynamodb.ProjectionType_Values(), false),
+						},
+						"non_key_attributes": {
+							Type:        schema.TypeSet,
+							Description: "Names of the non-key attributes copied to the index if `projection_type` is `INCLUDE`.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+						},
+					},
+				},
+			},
+			"ttl": {
+				Type:        schema.TypeList,
+				Description: "Time to live settings of the table.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute_name": {
+							Type:         schema.TypeString,
+							Description:  "Name of the attribute that stores the expiration time of the items, in seconds since the epoch.",
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Description: "Whether the expired items are deleted.",
+							Optional:    true,
+							Default:     true,
+						},
+					},
+				},
+			},
+			"access_key": {
+				Type:         schema.TypeString,
+				Description:  "The [access key](https://yandex.cloud/docs/iam/operations/sa/create-access-key) of a service account to use for the requests. The IAM token of the provider is used if not specified.",
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"secret_key"},
+			},
+			"secret_key": {
+				Type:         schema.TypeString,
+				Description:  "The [secret key](https://yandex.cloud/docs/iam/operations/sa/create-access-key) of a service account to use for the requests.",
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"access_key"},
+			},
+			"region_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the region where the database is located. The region of the provider is used if not specified.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+		},
+		SchemaVersion: 0,
+		CustomizeDiff: resourceYandexYDBDocumentTableCustomizeDiff,
+		CreateContext: resourceYandexYDBDocumentTableCreate,
+		ReadContext:   resourceYandexYDBDocumentTableRead,
+		UpdateContext: resourceYandexYDBDocumentTableUpdate,
+		DeleteContext: resourceYandexYDBDocumentTableDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexYDBDocumentTableImport,
+		},
+		Timeouts: ydbTimeouts(),
+	}
+}
+
+// resourceYandexYDBDocumentTableCustomizeDiff rejects a change of the attribute definitions of an existing table
+// unless the indexes are changed too, since the definitions can only be sent along with a new index.
+func resourceYandexYDBDocumentTableCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("attribute") || diff.HasChange("global_secondary_index") {
+		return nil
+	}
+	return fmt.Errorf("attribute definitions of an existing Document API table can only be changed along with global_secondary_index: " +
+		"define only the attributes used as keys of the table and of its indexes, or replace the table to change the type of its key")
+}
+
+// signYDBDocumentAPIRequest authenticates a Document API request with the IAM token of the provider
+// instead of the AWS signature.
+func signYDBDocumentAPIRequest(config *Config) func(r *request.Request) {
+	return func(r *request.Request) {
+		token, err := config.getIAMToken(r.Context())
+		if err != nil {
+			r.Error = fmt.Errorf("failed to create token for Document API request: %w", err)
+			return
+		}
+		r.HTTPRequest.Header.Set("Authorization", "Bearer "+token)
+	}
+}
+
+func newYDBDocumentAPIClient(d *schema.ResourceData, meta interface{}) (*dynamodb.DynamoDB, error) {
+	providerConfig := meta.(*Config)
+
+	config := &aws.Config{
+		Endpoint: aws.String(d.Get("document_api_endpoint").(string)),
+		Region:   aws.String(providerConfig.Region),
+	}
+	if v, ok := d.GetOk("region_id"); ok {
+		config.WithRegion(v.(string))
+	}
+	if config.Region == nil || *config.Region == "" {
+		config.WithRegion(defaultYMQRegion)
+	}
+
+	var useIAMToken bool
+	if accessKey, ok := d.GetOk("access_key"); ok {
+		log.Printf("[DEBUG] Use access and secret keys specified in YDB document table resource")
+		config.WithCredentials(credentials.NewStaticCredentials(accessKey.(string), d.Get("secret_key").(string), ""))
+	} else {
+		// the AWS signature is skipped for anonymous credentials, the IAM token is added by the sign handler
+		config.WithCredentials(credentials.AnonymousCredentials)
+		useIAMToken = true
+	}
+
+	newSession, err := session.NewSession(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Document API client: %w", err)
+	}
+	client := dynamodb.New(newSession)
+	if useIAMToken {
+		client.Handlers.Sign.PushBack(signYDBDocumentAPIRequest(providerConfig))
+	}
+	return client, nil
+}
+
+func resourceYandexYDBDocumentTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newYDBDocumentAPIClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	req := &dynamodb.CreateTableInput{
+		TableName:            aws.String(name),
+		BillingMode:          aws.String(dynamodb.BillingModePayPerRequest),
+		AttributeDefinitions: expandYDBDocumentTableAttributes(d.Get("attribute").(*schema.Set)),
+		KeySchema:            expandYDBDocumentTableKeySchema(d.Get("hash_key").(string), d.Get("range_key").(string)),
+	}
+	for _, index := range d.Get("global_secondary_index").(*schema.Set).List() {
+		req.GlobalSecondaryIndexes = append(req.GlobalSecondaryIndexes, expandYDBDocumentTableIndex(index.(map[string]interface{})))
+	}
+
+	log.Printf("[DEBUG] Creating YDB document table: %s", req)
+	if _, err := client.CreateTableWithContext(ctx, req); err != nil {
+		return diag.Errorf("failed to create YDB document table %q: %s", name, err)
+	}
+	d.SetId(ydbAccessID(d.Get("document_api_endpoint").(string), "table", name))
+
+	if err := waitForYDBDocumentTableActive(ctx, client, name, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if v, ok := d.GetOk("ttl"); ok {
+		ttl := v.([]interface{})[0].(map[string]interface{})
+		if ttl["enabled"].(bool) {
+			if err := updateYDBDocumentTableTTL(ctx, client, name, ttl["attribute_name"].(string), true); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceYandexYDBDocumentTableRead(ctx, d, meta)
+}
+
+func resourceYandexYDBDocumentTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newYDBDocumentAPIClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	resp, err := client.DescribeTableWithContext(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(name)})
+	if err != nil {
+		if isYDBDocumentTableNotFound(err) {
+			log.Printf("[WARN] YDB document table %q not found, removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to describe YDB document table %q: %s", name, err)
+	}
+	table := resp.Table
+
+	hashKey, rangeKey := flattenYDBDocumentTableKeySchema(table.KeySchema)
+	d.Set("hash_key", hashKey)
+	d.Set("range_key", rangeKey)
+	if err := d.Set("attribute", flattenYDBDocumentTableAttributes(table.AttributeDefinitions)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("global_secondary_index", flattenYDBDocumentTableIndexes(table.GlobalSecondaryIndexes)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	ttlResp, err := client.DescribeTimeToLiveWithContext(ctx, &dynamodb.DescribeTimeToLiveInput{TableName: aws.String(name)})
+	if err != nil {
+		return diag.Errorf("failed to describe time to live of YDB document table %q: %s", name, err)
+	}
+	_, configured := d.GetOk("ttl")
+	if err := d.Set("ttl", flattenYDBDocumentTableTTL(ttlResp.TimeToLiveDescription, configured)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceYandexYDBDocumentTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newYDBDocumentAPIClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	name := d.Get("name").(string)
+
+	if d.HasChange("global_secondary_index") {
+		o, n := d.GetChange("global_secondary_index")
+		attributes := expandYDBDocumentTableAttributes(d.Get("attribute").(*schema.Set))
+
+		// only one index can be created or deleted by a request, and the table must be active before the next one
+		for _, index := range o.(*schema.Set).Difference(n.(*schema.Set)).List() {
+			indexName := index.(map[string]interface{})["name"].(string)
+			log.Printf("[DEBUG] Deleting index %q of YDB document table %q", indexName, name)
+			_, err := client.UpdateTableWithContext(ctx, &dynamodb.UpdateTableInput{
+				TableName: aws.String(name),
+				GlobalSecondaryIndexUpdates: []*dynamodb.GlobalSecondaryIndexUpdate{{
+					Delete: &dynamodb.DeleteGlobalSecondaryIndexAction{IndexName: aws.String(indexName)},
+				}},
+			})
+			if err != nil {
+				return diag.Errorf("failed to delete index %q of YDB document table %q: %s", indexName, name, err)
+			}
+			if err := waitForYDBDocumentTableActive(ctx, client, name, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		for _, index := range n.(*schema.Set).Difference(o.(*schema.Set)).List() {
+			gsi := expandYDBDocumentTableIndex(index.(map[string]interface{}))
+			log.Printf("[DEBUG] Creating index %q of YDB document table %q", *gsi.IndexName, name)
+			_, err := client.UpdateTableWithContext(ctx, &dynamodb.UpdateTableInput{
+				TableName:            aws.String(name),
+				AttributeDefinitions: attributes,
+				GlobalSecondaryIndexUpdates: []*dynamodb.GlobalSecondaryIndexUpdate{{
+					Create: &dynamodb.CreateGlobalSecondaryIndexAction{
+						IndexName:  gsi.IndexName,
+						KeySchema:  gsi.KeySchema,
+						Projection: gsi.Projection,
+					},
+				}},
+			})
+			if err != nil {
+				return diag.Errorf("failed to create index %q of YDB document table %q: %s", *gsi.IndexName, name, err)
+			}
+			if err := waitForYDBDocumentTableActive(ctx, client, name, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("ttl") {
+		o, n := d.GetChange("ttl")
+		if ttl := n.([]interface{}); len(ttl) > 0 {
+			ttl := ttl[0].(map[string]interface{})
+			err = updateYDBDocumentTableTTL(ctx, client, name, ttl["attribute_name"].(string), ttl["enabled"].(bool))
+		} else if ttl := o.([]interface{}); len(ttl) > 0 && ttl[0].(map[string]interface{})["enabled"].(bool) {
+			err = updateYDBDocumentTableTTL(ctx, client, name, ttl[0].(map[string]interface{})["attribute_name"].(string), false)
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceYandexYDBDocumentTableRead(ctx, d, meta)
+}
+
+func resourceYandexYDBDocumentTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newYDBDocumentAPIClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	log.Printf("[DEBUG] Deleting YDB document table %q", name)
+	if _, err := client.DeleteTableWithContext(ctx, &dynamodb.DeleteTableInput{TableName: aws.String(name)}); err != nil {
+		if isYDBDocumentTableNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("failed to delete YDB document table %q: %s", name, err)
+	}
+
+	err = client.WaitUntilTableNotExistsWithContext(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(name)})
+	if err != nil {
+		return diag.Errorf("failed to wait for deletion of YDB document table %q: %s", name, err)
+	}
+	return nil
+}
+
+func resourceYandexYDBDocumentTableImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	endpoint, values, err := parseYDBAccessID(d.Id(), "table")
+	if err != nil {
+		return nil, fmt.Errorf("failed to import YDB document table: %w", err)
+	}
+
+	d.Set("document_api_endpoint", endpoint)
+	d.Set("name", values["table"])
+	return []*schema.ResourceData{d}, nil
+}
+
+func isYDBDocumentTableNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == dynamodb.ErrCodeResourceNotFoundException
+}
+
+// waitForYDBDocumentTableActive waits for the table and all of its indexes to become active.
+func waitForYDBDocumentTableActive(ctx context.Context, client *dynamodb.DynamoDB, name string, timeout time.Duration) error {
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		resp, err := client.DescribeTableWithContext(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(name)})
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if status := aws.StringValue(resp.Table.TableStatus); status != dynamodb.TableStatusActive {
+			return retry.RetryableError(fmt.Errorf("table is %s", status))
+		}
+		for _, index := range resp.Table.GlobalSecondaryIndexes {
+			if status := aws.StringValue(index.IndexStatus); status != dynamodb.IndexStatusActive {
+				return retry.RetryableError(fmt.Errorf("index %q is %s", aws.StringValue(index.IndexName), status))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to wait for YDB document table %q to become active: %w", name, err)
+	}
+	return nil
+}
+
+func updateYDBDocumentTableTTL(ctx context.Context, client *dynamodb.DynamoDB, name, attributeName string, enabled bool) error {
+	log.Printf("[DEBUG] Updating time to live of YDB document table %q: attribute %q, enabled %t", name, attributeName, enabled)
+	_, err := client.UpdateTimeToLiveWithContext(ctx, &dynamodb.UpdateTimeToLiveInput{
+		TableName: aws.String(name),
+		TimeToLiveSpecification: &dynamodb.TimeToLiveSpecification{
+			AttributeName: aws.String(attributeName),
+			Enabled:       aws.Bool(enabled),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update time to live of YDB document table %q: %w", name, err)
+	}
+	return nil
+}
+
+func expandYDBDocumentTableKeySchema(hashKey, rangeKey string) []*dynamodb.KeySchemaElement {
+	keys := []*dynamodb.KeySchemaElement{{
+		AttributeName: aws.String(hashKey),
+		KeyType:       aws.String(dynamodb.KeyTypeHash),
+	}}
+	if rangeKey != "" {
+		keys = append(keys, &dynamodb.KeySchemaElement{
+			AttributeName: aws.String(rangeKey),
+			KeyType:       aws.String(dynamodb.KeyTypeRange),
+		})
+	}
+	return keys
+}
+
+func flattenYDBDocumentTableKeySchema(keys []*dynamodb.KeySchemaElement) (hashKey, rangeKey string) {
+	for _, key := range keys {
+		switch aws.StringValue(key.KeyType) {
+		case dynamodb.KeyTypeHash:
+			hashKey = aws.StringValue(key.AttributeName)
+		case dynamodb.KeyTypeRange:
+			rangeKey = aws.StringValue(key.AttributeName)
+		}
+	}
+	return hashKey, rangeKey
+}
+
+func expandYDBDocumentTableAttributes(set *schema.Set) []*dynamodb.AttributeDefinition {
+	attributes := make([]*dynamodb.AttributeDefinition, 0, set.Len())
+	for _, v := range set.List() {
+		attribute := v.(map[string]interface{})
+		attributes = append(attributes, &dynamodb.AttributeDefinition{
+			AttributeName: aws.String(attribute["name"].(string)),
+			AttributeType: aws.String(attribute["type"].(string)),
+		})
+	}
+	sort.Slice(attributes, func(i, j int) bool {
+		return *attributes[i].AttributeName < *attributes[j].AttributeName
+	})
+	return attributes
+}
+
+func flattenYDBDocumentTableAttributes(attributes []*dynamodb.AttributeDefinition) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(attributes))
+	for _, attribute := range attributes {
+		result = append(result, map[string]interface{}{
+			"name": aws.StringValue(attribute.AttributeName),
+			"type": aws.StringValue(attribute.AttributeType),
+		})
+	}
+	return result
+}
+
+func expandYDBDocumentTableIndex(index map[string]interface{}) *dynamodb.GlobalSecondaryIndex {
+	projection := &dynamodb.Projection{
+		ProjectionType: aws.String(index["projection_type"].(string)),
+	}
+	if v, ok := index["non_key_attributes"].(*schema.Set); ok && v.Len() > 0 {
+		projection.NonKeyAttributes = aws.StringSlice(convertStringSet(v))
+	}
+
+	rangeKey, _ := index["range_key"].(string)
+	return &dynamodb.GlobalSecondaryIndex{
+		IndexName:  aws.String(index["name"].(string)),
+		KeySchema:  expandYDBDocumentTableKeySchema(index["hash_key"].(string), rangeKey),
+		Projection: projection,
+	}
+}
+
+func flattenYDBDocumentTableIndexes(indexes []*dynamodb.GlobalSecondaryIndexDescription) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(indexes))
+	for _, index := range indexes {
+		hashKey, rangeKey := flattenYDBDocumentTableKeySchema(index.KeySchema)
+		m := map[string]interface{}{
+			"name":            aws.StringValue(index.IndexName),
+			"hash_key":        hashKey,
+			"range_key":       rangeKey,
+			"projection_type": dynamodb.ProjectionTypeAll,
+		}
+		if index.Projection != nil {
+			m["projection_type"] = aws.StringValue(index.Projection.ProjectionType)
+			m["non_key_attributes"] = aws.StringValueSlice(index.Projection.NonKeyAttributes)
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+// flattenYDBDocumentTableTTL returns the time to live settings of the table. The disabled settings
+// are kept in the state only if they are configured, so that a table without them doesn't have a diff.
+func flattenYDBDocumentTableTTL(ttl *dynamodb.TimeToLiveDescription, configured bool) []map[string]interface{} {
+	if ttl == nil || aws.StringValue(ttl.AttributeName) == "" {
+		return nil
+	}
+
+	status := aws.StringValue(ttl.TimeToLiveStatus)
+	enabled := status == dynamodb.TimeToLiveStatusEnabled || status == dynamodb.TimeToLiveStatusEnabling
+	if !enabled && !configured {
+		return nil
+	}
+	return []map[string]interface{}{{
+		"attribute_name": aws.StringValue(ttl.AttributeName),
+		"enabled":        enabled,
+	}}
+}
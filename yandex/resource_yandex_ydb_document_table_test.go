@@ -0,0 +1,146 @@
+package yandex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/iamtoken"
+)
+
+func TestYDBDocumentTableKeySchema(t *testing.T) {
+	hashKey, rangeKey := flattenYDBDocumentTableKeySchema(expandYDBDocumentTableKeySchema("user_id", "created_at"))
+	assert.Equal(t, "user_id", hashKey)
+	assert.Equal(t, "created_at", rangeKey)
+
+	keys := expandYDBDocumentTableKeySchema("user_id", "")
+	require.Len(t, keys, 1)
+	assert.Equal(t, dynamodb.KeyTypeHash, aws.StringValue(keys[0].KeyType))
+}
+
+func TestYDBDocumentTableIndex(t *testing.T) {
+	index := map[string]interface{}{
+		"name":               "by_email",
+		"hash_key":           "email",
+		"range_key":          "",
+		"projection_type":    dynamodb.ProjectionTypeInclude,
+		"non_key_attributes": schema.NewSet(schema.HashString, []interface{}{"name"}),
+	}
+	gsi := expandYDBDocumentTableIndex(index)
+	assert.Equal(t, "by_email", aws.StringValue(gsi.IndexName))
+	require.Len(t, gsi.KeySchema, 1)
+	assert.Equal(t, []string{"name"}, aws.StringValueSlice(gsi.Projection.NonKeyAttributes))
+
+	flattened := flattenYDBDocumentTableIndexes([]*dynamodb.GlobalSecondaryIndexDescription{{
+		IndexName:  gsi.IndexName,
+		KeySchema:  gsi.KeySchema,
+		Projection: gsi.Projection,
+	}})
+	assert.Equal(t, []map[string]interface{}{{
+		"name":               "by_email",
+		"hash_key":           "email",
+		"range_key":          "",
+		"projection_type":    dynamodb.ProjectionTypeInclude,
+		"non_key_attributes": []string{"name"},
+	}}, flattened)
+}
+
+func TestYDBDocumentTableAttributeDiff(t *testing.T) {
+	raw := func(attributes []interface{}, indexes []interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"document_api_endpoint":  "https://docapi.serverless.yandexcloud.net/ru-central1/b1g/etn",
+			"name":                   "orders",
+			"hash_key":               "id",
+			"attribute":              attributes,
+			"global_secondary_index": indexes,
+		}
+	}
+	id := map[string]interface{}{"name": "id", "type": dynamodb.ScalarAttributeTypeS}
+	email := map[string]interface{}{"name": "email", "type": dynamodb.ScalarAttributeTypeS}
+	byEmail := map[string]interface{}{"name": "by_email", "hash_key": "email"}
+
+	d := schema.TestResourceDataRaw(t, resourceYandexYDBDocumentTable().Schema, raw([]interface{}{id}, nil))
+	d.SetId("orders")
+	state := d.State()
+
+	// the attribute can't be defined without an index using it
+	_, err := resourceYandexYDBDocumentTable().SimpleDiff(context.Background(), state,
+		terraform.NewResourceConfigRaw(raw([]interface{}{id, email}, nil)), nil)
+	assert.ErrorContains(t, err, "global_secondary_index")
+
+	diff, err := resourceYandexYDBDocumentTable().SimpleDiff(context.Background(), state,
+		terraform.NewResourceConfigRaw(raw([]interface{}{id, email}, []interface{}{byEmail})), nil)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	assert.Equal(t, "2", diff.Attributes["attribute.#"].New)
+}
+
+func TestYDBDocumentTableTTL(t *testing.T) {
+	enabled := &dynamodb.TimeToLiveDescription{
+		AttributeName:    aws.String("expire_at"),
+		TimeToLiveStatus: aws.String(dynamodb.TimeToLiveStatusEnabled),
+	}
+	assert.Equal(t, []map[string]interface{}{{"attribute_name": "expire_at", "enabled": true}}, flattenYDBDocumentTableTTL(enabled, false))
+
+	disabled := &dynamodb.TimeToLiveDescription{
+		AttributeName:    aws.String("expire_at"),
+		TimeToLiveStatus: aws.String(dynamodb.TimeToLiveStatusDisabled),
+	}
+	assert.Nil(t, flattenYDBDocumentTableTTL(disabled, false))
+	assert.Equal(t, []map[string]interface{}{{"attribute_name": "expire_at", "enabled": false}}, flattenYDBDocumentTableTTL(disabled, true))
+
+	assert.Nil(t, flattenYDBDocumentTableTTL(&dynamodb.TimeToLiveDescription{}, true))
+}
+
+func TestYDBDocumentAPIClientAuth(t *testing.T) {
+	var authorization, target string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		target = r.Header.Get("X-Amz-Target")
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"Table": map[string]interface{}{"TableName": "users", "TableStatus": dynamodb.TableStatusActive},
+		})
+	}))
+	defer server.Close()
+
+	config := &Config{Region: "ru-central1"}
+	config.iamTokenSource = iamtoken.NewSource(func(ctx context.Context) (*iam.CreateIamTokenResponse, error) {
+		return &iam.CreateIamTokenResponse{IamToken: "t1.token", ExpiresAt: timestamppb.New(time.Now().Add(time.Hour))}, nil
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceYandexYDBDocumentTable().Schema, map[string]interface{}{
+		"document_api_endpoint": server.URL + "/ru-central1/b1gexample/etnexample",
+		"name":                  "users",
+	})
+	client, err := newYDBDocumentAPIClient(d, config)
+	require.NoError(t, err)
+
+	require.NoError(t, waitForYDBDocumentTableActive(context.Background(), client, "users", time.Minute))
+	assert.Equal(t, "Bearer t1.token", authorization)
+	assert.Equal(t, "DynamoDB_20120810.DescribeTable", target)
+
+	d = schema.TestResourceDataRaw(t, resourceYandexYDBDocumentTable().Schema, map[string]interface{}{
+		"document_api_endpoint": server.URL + "/ru-central1/b1gexample/etnexample",
+		"name":                  "users",
+		"access_key":            "YCAJEexample",
+		"secret_key":            "YCexample",
+	})
+	client, err = newYDBDocumentAPIClient(d, config)
+	require.NoError(t, err)
+
+	require.NoError(t, waitForYDBDocumentTableActive(context.Background(), client, "users", time.Minute))
+	assert.Contains(t, authorization, "AWS4-HMAC-SHA256 Credential=YCAJEexample/")
+}
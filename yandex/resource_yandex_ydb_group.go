@@ -0,0 +1,173 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	ydbGroupQuery = `
+DECLARE $name AS Utf8;
+SELECT COALESCE(Sid, ""u) FROM ` + "`.sys/auth_groups`" + ` WHERE Sid = $name;
+`
+	ydbGroupMembersQuery = `
+DECLARE $name AS Utf8;
+SELECT COALESCE(MemberSid, ""u) FROM ` + "`.sys/auth_group_members`" + ` WHERE GroupSid = $name;
+`
+)
+
+func resourceYandexYDBGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a group of users of a YDB database. For more information, see [the official documentation](https://yandex.cloud/docs/ydb/concepts/#ydb).\n\n" +
+			"~> Database groups are supported by dedicated databases only.\n",
+
+		Schema: map[string]*schema.Schema{
+			"connection_string": ydbAccessConnectionStringSchema(),
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "Name of the group.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"members": {
+				Type:        schema.TypeSet,
+				Description: "Names of the users and groups that are members of the group.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+		},
+		SchemaVersion: 0,
+		CreateContext: resourceYandexYDBGroupCreate,
+		ReadContext:   resourceYandexYDBGroupRead,
+		UpdateContext: resourceYandexYDBGroupUpdate,
+		DeleteContext: resourceYandexYDBGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexYDBGroupImport,
+		},
+		Timeouts: ydbTimeouts(),
+	}
+}
+
+func resourceYandexYDBGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connectionString := d.Get("connection_string").(string)
+	name := d.Get("name").(string)
+
+	db, err := openYDBConnection(ctx, meta, connectionString)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	yql := "CREATE GROUP " + quoteYDBIdentifier(name)
+	if members := convertStringSet(d.Get("members").(*schema.Set)); len(members) > 0 {
+		yql += " WITH USER " + quoteYDBIdentifiers(members)
+	}
+
+	log.Printf("[DEBUG] Creating YDB group %q", name)
+	if err := db.Query().Exec(ctx, yql); err != nil {
+		return diag.Errorf("failed to create YDB group %q: %s", name, err)
+	}
+
+	d.SetId(ydbAccessID(connectionString, "group", name))
+	return resourceYandexYDBGroupRead(ctx, d, meta)
+}
+
+func resourceYandexYDBGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	db, err := openYDBConnection(ctx, meta, d.Get("connection_string").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	groups, err := queryYDBSids(ctx, db, ydbGroupQuery, name)
+	if err != nil {
+		return diag.Errorf("failed to read YDB group %q: %s", name, err)
+	}
+	if len(groups) == 0 {
+		log.Printf("[WARN] YDB group %q not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	members, err := queryYDBSids(ctx, db, ydbGroupMembersQuery, name)
+	if err != nil {
+		return diag.Errorf("failed to read members of YDB group %q: %s", name, err)
+	}
+	if err := d.Set("members", members); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceYandexYDBGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	if d.HasChange("members") {
+		o, n := d.GetChange("members")
+		add := convertStringSet(n.(*schema.Set).Difference(o.(*schema.Set)))
+		drop := convertStringSet(o.(*schema.Set).Difference(n.(*schema.Set)))
+
+		db, err := openYDBConnection(ctx, meta, d.Get("connection_string").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		defer func() {
+			_ = db.Close(ctx)
+		}()
+
+		log.Printf("[DEBUG] Updating members of YDB group %q: add %v, drop %v", name, add, drop)
+		if len(drop) > 0 {
+			if err := db.Query().Exec(ctx, "ALTER GROUP "+quoteYDBIdentifier(name)+" DROP USER "+quoteYDBIdentifiers(drop)); err != nil {
+				return diag.Errorf("failed to remove members of YDB group %q: %s", name, err)
+			}
+		}
+		if len(add) > 0 {
+			if err := db.Query().Exec(ctx, "ALTER GROUP "+quoteYDBIdentifier(name)+" ADD USER "+quoteYDBIdentifiers(add)); err != nil {
+				return diag.Errorf("failed to add members of YDB group %q: %s", name, err)
+			}
+		}
+	}
+	return resourceYandexYDBGroupRead(ctx, d, meta)
+}
+
+func resourceYandexYDBGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	db, err := openYDBConnection(ctx, meta, d.Get("connection_string").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	log.Printf("[DEBUG] Deleting YDB group %q", name)
+	if err := db.Query().Exec(ctx, "DROP GROUP IF EXISTS "+quoteYDBIdentifier(name)); err != nil {
+		return diag.Errorf("failed to delete YDB group %q: %s", name, err)
+	}
+	return nil
+}
+
+func resourceYandexYDBGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	connectionString, values, err := parseYDBAccessID(d.Id(), "group")
+	if err != nil {
+		return nil, fmt.Errorf("failed to import YDB group: %w", err)
+	}
+
+	d.Set("connection_string", connectionString)
+	d.Set("name", values["group"])
+	return []*schema.ResourceData{d}, nil
+}
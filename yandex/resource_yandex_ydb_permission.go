@@ -0,0 +1,278 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Scheme_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+)
+
+func resourceYandexYDBPermission() *schema.Resource {
+	return &schema.Resource{
+		Description: "Grants permissions on a path of a YDB database to a subject. For more information, see [the official documentation](https://yandex.cloud/docs/ydb/concepts/#ydb).\n\n" +
+			"~> Only the permissions listed in `permissions` are managed. The permissions granted to the subject on the path in other ways are left intact.\n",
+
+		Schema: map[string]*schema.Schema{
+			"connection_string": ydbAccessConnectionStringSchema(),
+			"path": {
+				Type:        schema.TypeString,
+				Description: "Path of the scheme object relative to the database root, e.g. `dir/table`. The database root is used if not specified.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"subject": {
+				Type:         schema.TypeString,
+				Description:  "Subject to grant the permissions to: a database user or group, or an IAM user or service account in the `<id>@as` form.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"permissions": {
+				Type:        schema.TypeSet,
+				Description: "Names of the permissions to grant, e.g. `ydb.generic.read`, `ydb.generic.write` or `ydb.generic.full`.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				Set: schema.HashString,
+			},
+			"interrupt_inheritance": {
+				Type:        schema.TypeBool,
+				Description: "Stop inheriting permissions of the parent directories on the path. This is a property of the path shared by all of its permissions, and it is not restored when the resource is deleted. YDB does not report the property, so it is only read back as `true` when some permissions of the parent directory are not effective on the path.",
+				Optional:    true,
+			},
+		},
+		SchemaVersion: 0,
+		CreateContext: resourceYandexYDBPermissionCreate,
+		ReadContext:   resourceYandexYDBPermissionRead,
+		UpdateContext: resourceYandexYDBPermissionUpdate,
+		DeleteContext: resourceYandexYDBPermissionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexYDBPermissionImport,
+		},
+		Timeouts: ydbTimeouts(),
+	}
+}
+
+func ydbPermissionID(d *schema.ResourceData) string {
+	return ydbAccessID(d.Get("connection_string").(string), "path", d.Get("path").(string), "subject", d.Get("subject").(string))
+}
+
+func resourceYandexYDBPermissionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := modifyYDBPermissions(ctx, d, meta, convertStringSet(d.Get("permissions").(*schema.Set)), nil); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(ydbPermissionID(d))
+	return resourceYandexYDBPermissionRead(ctx, d, meta)
+}
+
+func resourceYandexYDBPermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connectionString := d.Get("connection_string").(string)
+	path, err := ydbFullPath(connectionString, d.Get("path").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := openYDBConnection(ctx, meta, connectionString)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	entry, err := db.Scheme().DescribePath(ctx, path)
+	if err != nil {
+		if ydb.IsOperationErrorSchemeError(err) {
+			log.Printf("[WARN] YDB path %q not found, removing permission %q from state", path, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to describe YDB path %q: %s", path, err)
+	}
+
+	permissions := ydbSubjectPermissions(entry.Permissions, d.Get("subject").(string))
+	// the permissions granted in other ways are not managed by the resource, unless it's being imported
+	if managed := d.Get("permissions").(*schema.Set); managed.Len() > 0 {
+		permissions = convertStringSet(managed.Intersection(convertStringArrToSchemaSet(permissions)))
+	}
+	if len(permissions) == 0 {
+		log.Printf("[WARN] YDB permissions of %q not found on path %q, removing from state", d.Get("subject"), path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("permissions", permissions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	database, err := ydbFullPath(connectionString, "")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// the database root has no parent in the database to inherit from
+	if path != database {
+		parentPath := path[:strings.LastIndex(path, "/")]
+		parent, err := db.Scheme().DescribePath(ctx, parentPath)
+		if err != nil {
+			return diag.Errorf("failed to describe YDB path %q: %s", parentPath, err)
+		}
+		// otherwise the path may still have the inheritance interrupted, so the configured value is kept
+		if ydbInheritanceInterrupted(entry, parent) {
+			if err := d.Set("interrupt_inheritance", true); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	return nil
+}
+
+func resourceYandexYDBPermissionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	o, n := d.GetChange("permissions")
+	grant := convertStringSet(n.(*schema.Set).Difference(o.(*schema.Set)))
+	revoke := convertStringSet(o.(*schema.Set).Difference(n.(*schema.Set)))
+
+	if err := modifyYDBPermissions(ctx, d, meta, grant, revoke); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceYandexYDBPermissionRead(ctx, d, meta)
+}
+
+func resourceYandexYDBPermissionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connectionString := d.Get("connection_string").(string)
+	path, err := ydbFullPath(connectionString, d.Get("path").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := openYDBConnection(ctx, meta, connectionString)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	err = db.Scheme().ModifyPermissions(ctx, path, scheme.WithRevokePermissions(scheme.Permissions{
+		Subject:         d.Get("subject").(string),
+		PermissionNames: convertStringSet(d.Get("permissions").(*schema.Set)),
+	}))
+	if err != nil && !ydb.IsOperationErrorSchemeError(err) {
+		return diag.Errorf("failed to revoke YDB permissions on path %q: %s", path, err)
+	}
+	return nil
+}
+
+func resourceYandexYDBPermissionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	connectionString, values, err := parseYDBAccessID(d.Id(), "path", "subject")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("connection_string", connectionString)
+	d.Set("path", values["path"])
+	d.Set("subject", values["subject"])
+	return []*schema.ResourceData{d}, nil
+}
+
+// modifyYDBPermissions grants and revokes permissions of the subject on the path, and sets the inheritance
+// of the path if it's changed.
+func modifyYDBPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}, grant, revoke []string) error {
+	connectionString := d.Get("connection_string").(string)
+	path, err := ydbFullPath(connectionString, d.Get("path").(string))
+	if err != nil {
+		return err
+	}
+	subject := d.Get("subject").(string)
+
+	req := &Ydb_Scheme.ModifyPermissionsRequest{
+		Path: path,
+	}
+	if len(revoke) > 0 {
+		req.Actions = append(req.Actions, &Ydb_Scheme.PermissionsAction{
+			Action: &Ydb_Scheme.PermissionsAction_Revoke{
+				Revoke: &Ydb_Scheme.Permissions{Subject: subject, PermissionNames: revoke},
+			},
+		})
+	}
+	if len(grant) > 0 {
+		req.Actions = append(req.Actions, &Ydb_Scheme.PermissionsAction{
+			Action: &Ydb_Scheme.PermissionsAction_Grant{
+				Grant: &Ydb_Scheme.Permissions{Subject: subject, PermissionNames: grant},
+			},
+		})
+	}
+	if d.HasChange("interrupt_inheritance") {
+		req.Inheritance = &Ydb_Scheme.ModifyPermissionsRequest_InterruptInheritance{
+			InterruptInheritance: d.Get("interrupt_inheritance").(bool),
+		}
+	}
+	if len(req.Actions) == 0 && req.Inheritance == nil {
+		return nil
+	}
+
+	db, err := openYDBConnection(ctx, meta, connectionString)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	log.Printf("[DEBUG] Modifying YDB permissions of %q on path %q: grant %v, revoke %v", subject, path, grant, revoke)
+
+	// the scheme client of the SDK can't change the inheritance, so the service is called directly
+	resp, err := Ydb_Scheme_V1.NewSchemeServiceClient(ydb.GRPCConn(db)).ModifyPermissions(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to modify YDB permissions on path %q: %w", path, err)
+	}
+	if op := resp.GetOperation(); op.GetStatus() != Ydb.StatusIds_SUCCESS {
+		return fmt.Errorf("failed to modify YDB permissions on path %q: %s: %v", path, op.GetStatus(), op.GetIssues())
+	}
+	return nil
+}
+
+// ydbInheritanceInterrupted tells from the described entries of the path and its parent whether the inheritance of
+// the parent's permissions is interrupted on the path, since the entries don't have the flag itself. It is only known
+// to be interrupted if some effective permissions of the parent are not effective on the path: a path that re-grants
+// all permissions of its parent looks the same whether it inherits them or not.
+func ydbInheritanceInterrupted(entry, parent scheme.Entry) bool {
+	effective := make(map[string]bool)
+	for _, p := range entry.EffectivePermissions {
+		for _, name := range p.PermissionNames {
+			effective[p.Subject+"\x00"+name] = true
+		}
+	}
+	for _, p := range parent.EffectivePermissions {
+		for _, name := range p.PermissionNames {
+			if !effective[p.Subject+"\x00"+name] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ydbSubjectPermissions returns the names of the permissions granted to the subject directly, sorted.
+func ydbSubjectPermissions(permissions []scheme.Permissions, subject string) []string {
+	var names []string
+	for _, p := range permissions {
+		if p.Subject == subject {
+			names = append(names, p.PermissionNames...)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
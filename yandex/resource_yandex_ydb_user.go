@@ -0,0 +1,148 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const ydbUserQuery = `
+DECLARE $name AS Utf8;
+SELECT COALESCE(Sid, ""u) FROM ` + "`.sys/auth_users`" + ` WHERE Sid = $name;
+`
+
+func resourceYandexYDBUser() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a user of a YDB database. For more information, see [the official documentation](https://yandex.cloud/docs/ydb/concepts/#ydb).\n\n" +
+			"~> Database users are supported by dedicated databases only.\n",
+
+		Schema: map[string]*schema.Schema{
+			"connection_string": ydbAccessConnectionStringSchema(),
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "Name of the user.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "Password of the user. The user can't log in with a password if not specified.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+		SchemaVersion: 0,
+		CreateContext: resourceYandexYDBUserCreate,
+		ReadContext:   resourceYandexYDBUserRead,
+		UpdateContext: resourceYandexYDBUserUpdate,
+		DeleteContext: resourceYandexYDBUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexYDBUserImport,
+		},
+		Timeouts: ydbTimeouts(),
+	}
+}
+
+func ydbUserPasswordClause(d *schema.ResourceData) string {
+	if password := d.Get("password").(string); password != "" {
+		return " PASSWORD " + quoteYDBString(password)
+	}
+	return " PASSWORD NULL"
+}
+
+func resourceYandexYDBUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connectionString := d.Get("connection_string").(string)
+	name := d.Get("name").(string)
+
+	db, err := openYDBConnection(ctx, meta, connectionString)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	log.Printf("[DEBUG] Creating YDB user %q", name)
+	if err := db.Query().Exec(ctx, "CREATE USER "+quoteYDBIdentifier(name)+ydbUserPasswordClause(d)); err != nil {
+		return diag.Errorf("failed to create YDB user %q: %s", name, err)
+	}
+
+	d.SetId(ydbAccessID(connectionString, "user", name))
+	return resourceYandexYDBUserRead(ctx, d, meta)
+}
+
+func resourceYandexYDBUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	db, err := openYDBConnection(ctx, meta, d.Get("connection_string").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	users, err := queryYDBSids(ctx, db, ydbUserQuery, name)
+	if err != nil {
+		return diag.Errorf("failed to read YDB user %q: %s", name, err)
+	}
+	if len(users) == 0 {
+		log.Printf("[WARN] YDB user %q not found, removing from state", name)
+		d.SetId("")
+	}
+	return nil
+}
+
+func resourceYandexYDBUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	if d.HasChange("password") {
+		db, err := openYDBConnection(ctx, meta, d.Get("connection_string").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		defer func() {
+			_ = db.Close(ctx)
+		}()
+
+		log.Printf("[DEBUG] Updating password of YDB user %q", name)
+		if err := db.Query().Exec(ctx, "ALTER USER "+quoteYDBIdentifier(name)+ydbUserPasswordClause(d)); err != nil {
+			return diag.Errorf("failed to update YDB user %q: %s", name, err)
+		}
+	}
+	return resourceYandexYDBUserRead(ctx, d, meta)
+}
+
+func resourceYandexYDBUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	db, err := openYDBConnection(ctx, meta, d.Get("connection_string").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	log.Printf("[DEBUG] Deleting YDB user %q", name)
+	if err := db.Query().Exec(ctx, "DROP USER IF EXISTS "+quoteYDBIdentifier(name)); err != nil {
+		return diag.Errorf("failed to delete YDB user %q: %s", name, err)
+	}
+	return nil
+}
+
+func resourceYandexYDBUserImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	connectionString, values, err := parseYDBAccessID(d.Id(), "user")
+	if err != nil {
+		return nil, fmt.Errorf("failed to import YDB user: %w", err)
+	}
+
+	d.Set("connection_string", connectionString)
+	d.Set("name", values["user"])
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,256 @@
+package yandex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/endpoint"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/containers/v1"
+)
+
+const (
+	monitoringAPIEndpointID  = "monitoring"
+	monitoringDataReadPath   = "/monitoring/v2/data/read"
+	monitoringRequestTimeout = time.Minute
+	// monitoringReadRetryTimeout limits the retries of a single rollout check on transient errors.
+	monitoringReadRetryTimeout = 3 * time.Minute
+)
+
+// monitoringHTTPClient is shared by the rollout checks, so that the connections to Monitoring are reused.
+var monitoringHTTPClient = &http.Client{Timeout: monitoringRequestTimeout}
+
+type monitoringDataReadRequest struct {
+	Query    string `json:"query"`
+	FromTime string `json:"fromTime"`
+	ToTime   string `json:"toTime"`
+}
+
+// monitoringStatusError is returned when the Monitoring API responds with an error status.
+type monitoringStatusError struct {
+	statusCode int
+	body       []byte
+}
+
+func (e *monitoringStatusError) Error() string {
+	return fmt.Sprintf("monitoring API responded with status %d: %s", e.statusCode, e.body)
+}
+
+// rolloutThresholdExceededError is returned when a value of the rollout query exceeds the threshold,
+// which is the only case when the container is rolled back.
+type rolloutThresholdExceededError struct {
+	value, threshold float64
+}
+
+func (e *rolloutThresholdExceededError) Error() string {
+	return fmt.Sprintf("rollout query value %v exceeds threshold %v", e.value, e.threshold)
+}
+
+type monitoringDataReadResponse struct {
+	Metrics []struct {
+		Timeseries struct {
+			DoubleValues []float64 `json:"doubleValues"`
+			Int64Values  []int64   `json:"int64Values"`
+		} `json:"timeseries"`
+	} `json:"metrics"`
+}
+
+// getMonitoringDataReadURL resolves the Monitoring endpoint with the API endpoint service of the configured endpoint.
+func getMonitoringDataReadURL(ctx context.Context, config *Config, folderID string) (string, error) {
+	apiEndpoint, err := config.sdk.ApiEndpoint().ApiEndpoint().Get(ctx, &endpoint.GetApiEndpointRequest{
+		ApiEndpointId: monitoringAPIEndpointID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve monitoring API endpoint: %w", err)
+	}
+	return monitoringDataReadURL(apiEndpoint.GetAddress(), folderID), nil
+}
+
+func monitoringDataReadURL(address, folderID string) string {
+	u := url.URL{
+		Scheme:   "https",
+		Host:     strings.TrimSuffix(address, ":443"),
+		Path:     monitoringDataReadPath,
+		RawQuery: url.Values{"folderId": {folderID}}.Encode(),
+	}
+	return u.String()
+}
+
+// readMonitoringValues returns all values of the Monitoring query in the given time range.
+func readMonitoringValues(ctx context.Context, config *Config, dataReadURL, query string, from, to time.Time) ([]float64, error) {
+	token, err := config.getIAMToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IAM token: %w", err)
+	}
+
+	body, err := json.Marshal(monitoringDataReadRequest{
+		Query:    query,
+		FromTime: from.UTC().Format(time.RFC3339),
+		ToTime:   to.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, dataReadURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := monitoringHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, &monitoringStatusError{statusCode: resp.StatusCode, body: respBody}
+	}
+	return parseMonitoringValues(respBody)
+}
+
+// isTransientMonitoringError reports whether reading the rollout query may succeed when retried:
+// network errors, throttling and server errors are transient, while e.g. an invalid query is not.
+func isTransientMonitoringError(err error) bool {
+	var statusErr *monitoringStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.statusCode == http.StatusTooManyRequests || statusErr.statusCode >= http.StatusInternalServerError
+	}
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
+
+// readMonitoringValuesWithRetry retries readMonitoringValues with a backoff while its errors are transient.
+func readMonitoringValuesWithRetry(ctx context.Context, config *Config, dataReadURL, query string, from, to time.Time) ([]float64, error) {
+	var values []float64
+	err := retry.RetryContext(ctx, monitoringReadRetryTimeout, func() *retry.RetryError {
+		var err error
+		values, err = readMonitoringValues(ctx, config, dataReadURL, query, from, to)
+		if err == nil {
+			return nil
+		}
+		if isTransientMonitoringError(err) {
+			log.Printf("[DEBUG] Retrying to read rollout query: %s", err)
+			return retry.RetryableError(err)
+		}
+		return retry.NonRetryableError(err)
+	})
+	return values, err
+}
+
+func parseMonitoringValues(body []byte) ([]float64, error) {
+	var data monitoringDataReadResponse
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, fmt.Errorf("failed to parse monitoring API response: %w", err)
+	}
+
+	var values []float64
+	for _, metric := range data.Metrics {
+		values = append(values, metric.Timeseries.DoubleValues...)
+		for _, v := range metric.Timeseries.Int64Values {
+			values = append(values, float64(v))
+		}
+	}
+	return values, nil
+}
+
+// checkServerlessContainerRollout watches the rollout query of the container until the observation period
+// since deployedAt is over. It returns a *rolloutThresholdExceededError as soon as the query exceeds the threshold,
+// and other errors when the query can't be checked, e.g. when Monitoring is unavailable until the period is over.
+func checkServerlessContainerRollout(ctx context.Context, config *Config, d *schema.ResourceData, deployedAt time.Time) error {
+	if _, ok := d.GetOk("rollout"); !ok {
+		return nil
+	}
+
+	query := d.Get("rollout.0.query").(string)
+	threshold := d.Get("rollout.0.threshold").(float64)
+	observationPeriod, err := time.ParseDuration(d.Get("rollout.0.observation_period").(string))
+	if err != nil {
+		return fmt.Errorf("cannot parse rollout observation_period: %w", err)
+	}
+	checkInterval, err := time.ParseDuration(d.Get("rollout.0.check_interval").(string))
+	if err != nil {
+		return fmt.Errorf("cannot parse rollout check_interval: %w", err)
+	}
+
+	folderID, err := getFolderID(d, config)
+	if err != nil {
+		return err
+	}
+	dataReadURL, err := getMonitoringDataReadURL(ctx, config, folderID)
+	if err != nil {
+		return err
+	}
+
+	deadline := deployedAt.Add(observationPeriod)
+	for {
+		wait := time.Until(deadline)
+		if wait > checkInterval {
+			wait = checkInterval
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("rollout observation period exceeds the timeout: %w", ctx.Err())
+		case <-time.After(wait):
+		}
+
+		now := time.Now()
+		values, err := readMonitoringValuesWithRetry(ctx, config, dataReadURL, query, deployedAt, now)
+		if err != nil {
+			if !isTransientMonitoringError(err) || !now.Before(deadline) {
+				return fmt.Errorf("failed to read rollout query: %w", err)
+			}
+			// the next check covers the values since deployedAt as well
+			log.Printf("[WARN] Failed to read rollout query of Yandex Cloud Container %q, checking again in %s: %s", d.Id(), checkInterval, err)
+			continue
+		}
+		for _, v := range values {
+			if v > threshold {
+				return &rolloutThresholdExceededError{value: v, threshold: threshold}
+			}
+		}
+		log.Printf("[DEBUG] Rollout query of Yandex Cloud Container %q is within threshold (%d values)", d.Id(), len(values))
+
+		if !now.Before(deadline) {
+			return nil
+		}
+	}
+}
+
+func resourceYandexServerlessContainerRollback(
+	ctx context.Context,
+	config *Config,
+	containerID, revisionID string,
+	cause error,
+) diag.Diagnostics {
+	log.Printf("[WARN] Rolling back Yandex Cloud Container %q to revision %q: %s", containerID, revisionID, cause)
+	// the rollout check may have used up the update timeout, still try to roll back
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), yandexServerlessContainerDefaultTimeout)
+	defer cancel()
+
+	op, err := config.sdk.Serverless().Containers().Container().Rollback(ctx, &containers.RollbackContainerRequest{
+		ContainerId: containerID,
+		RevisionId:  revisionID,
+	})
+	err = waitOperation(ctx, config, op, err)
+	if err != nil {
+		return diag.Errorf("Failed to roll back Yandex Cloud Container to revision %q after failed rollout (%s): %s", revisionID, cause, err)
+	}
+	return diag.Errorf("Yandex Cloud Container was rolled back to revision %q: %s", revisionID, cause)
+}
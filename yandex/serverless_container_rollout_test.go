@@ -0,0 +1,50 @@
+package yandex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMonitoringValues(t *testing.T) {
+	body := []byte(`{
+		"metrics": [
+			{"name": "errors", "timeseries": {"timestamps": [1, 2], "doubleValues": [0.01, 0.2]}},
+			{"name": "count", "timeseries": {"timestamps": [1], "int64Values": [3]}}
+		]
+	}`)
+
+	values, err := parseMonitoringValues(body)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0.01, 0.2, 3}, values)
+
+	values, err = parseMonitoringValues([]byte(`{"metrics": []}`))
+	require.NoError(t, err)
+	assert.Empty(t, values)
+
+	_, err = parseMonitoringValues([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestMonitoringDataReadURL(t *testing.T) {
+	assert.Equal(t, "https://monitoring.api.cloud.yandex.net/monitoring/v2/data/read?folderId=b1g123",
+		monitoringDataReadURL("monitoring.api.cloud.yandex.net:443", "b1g123"))
+	assert.Equal(t, "https://monitoring.example.com:8443/monitoring/v2/data/read?folderId=b1g%26x",
+		monitoringDataReadURL("monitoring.example.com:8443", "b1g&x"))
+}
+
+func TestIsTransientMonitoringError(t *testing.T) {
+	assert.True(t, isTransientMonitoringError(errors.New("connection reset by peer")))
+	assert.True(t, isTransientMonitoringError(&monitoringStatusError{statusCode: http.StatusTooManyRequests}))
+	assert.True(t, isTransientMonitoringError(&monitoringStatusError{statusCode: http.StatusServiceUnavailable}))
+	assert.True(t, isTransientMonitoringError(fmt.Errorf("failed to get IAM token: %w", errors.New("timeout"))))
+
+	assert.False(t, isTransientMonitoringError(&monitoringStatusError{statusCode: http.StatusBadRequest}))
+	assert.False(t, isTransientMonitoringError(&monitoringStatusError{statusCode: http.StatusForbidden}))
+	assert.False(t, isTransientMonitoringError(context.DeadlineExceeded))
+}
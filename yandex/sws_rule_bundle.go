@@ -0,0 +1,432 @@
+package yandex
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/smartwebsecurity/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const swsMaxRulePriority = 999999
+
+// swsRuleBundle is a set of security rules shared by several security profiles.
+// The priorities of its rules are shifted by the offset when the bundle is merged into a profile,
+// zero offset places the bundle after the preceding rules.
+type swsRuleBundle struct {
+	rules          []*smartwebsecurity.SecurityRule
+	priorityOffset int64
+}
+
+// marshalSWSRuleBundle returns the normalized JSON of the rules, used as the rules_json of a bundle.
+func marshalSWSRuleBundle(rules []*smartwebsecurity.SecurityRule) (string, error) {
+	// protojson output isn't stable, so it's normalized to compare the bundles
+	bytes, err := protojson.Marshal(&smartwebsecurity.SecurityProfile{SecurityRules: rules})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal security rules: %w", err)
+	}
+	return structure.NormalizeJsonString(string(bytes))
+}
+
+func unmarshalSWSRuleBundle(rulesJSON string) ([]*smartwebsecurity.SecurityRule, error) {
+	profile := new(smartwebsecurity.SecurityProfile)
+	if err := protojson.Unmarshal([]byte(rulesJSON), profile); err != nil {
+		return nil, fmt.Errorf("failed to parse security rules of the rule bundle: %w", err)
+	}
+	return profile.GetSecurityRules(), nil
+}
+
+func expandSWSRuleBundles(v []interface{}) ([]swsRuleBundle, error) {
+	bundles := make([]swsRuleBundle, 0, len(v))
+	for i, raw := range v {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rules, err := unmarshalSWSRuleBundle(m["rules_json"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("rule_bundle.%d: %w", i, err)
+		}
+		bundles = append(bundles, swsRuleBundle{
+			rules:          rules,
+			priorityOffset: int64(m["priority_offset"].(int)),
+		})
+	}
+	return bundles, nil
+}
+
+// mergeSWSRuleBundles returns the rules of the profile followed by the rules of the bundles in their order,
+// with the priorities of the bundle rules shifted by the offsets of the bundles. The merged rules are validated.
+func mergeSWSRuleBundles(rules []*smartwebsecurity.SecurityRule, bundles []swsRuleBundle) ([]*smartwebsecurity.SecurityRule, error) {
+	merged := append([]*smartwebsecurity.SecurityRule{}, rules...)
+	for _, bundle := range bundles {
+		offset := swsRuleBundleOffset(bundle.priorityOffset, merged)
+		for _, rule := range bundle.rules {
+			rule = proto.Clone(rule).(*smartwebsecurity.SecurityRule)
+			rule.Priority += offset
+			merged = append(merged, rule)
+		}
+	}
+
+	if err := validateSWSSecurityRules(merged); err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
+
+// swsRuleBundleOffset returns the offset of the priorities of a bundle placed after the preceding rules.
+// Zero offset places the bundle automatically: its rules are renumbered to follow the highest preceding priority.
+func swsRuleBundleOffset(priorityOffset int64, preceding []*smartwebsecurity.SecurityRule) int64 {
+	if priorityOffset != 0 {
+		return priorityOffset
+	}
+	var offset int64
+	for _, rule := range preceding {
+		if rule.GetPriority() > offset {
+			offset = rule.GetPriority()
+		}
+	}
+	return offset
+}
+
+// splitSWSRuleBundles separates the rules of the profile returned by the API into its own rules and the rules of
+// the bundles from the state. The rules_json of a bundle is rebuilt from the API if its rules have been changed
+// or removed outside of Terraform, so that the difference is shown in the plan.
+func splitSWSRuleBundles(rules []*smartwebsecurity.SecurityRule, bundles []interface{}) ([]*smartwebsecurity.SecurityRule, []interface{}, error) {
+	byName := make(map[string]*smartwebsecurity.SecurityRule, len(rules))
+	for _, rule := range rules {
+		byName[rule.GetName()] = rule
+	}
+
+	expectedRules := make([][]*smartwebsecurity.SecurityRule, len(bundles))
+	bundleRuleNames := make(map[string]bool)
+	for i, raw := range bundles {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		expected, err := unmarshalSWSRuleBundle(m["rules_json"].(string))
+		if err != nil {
+			return nil, nil, fmt.Errorf("rule_bundle.%d: %w", i, err)
+		}
+		for _, rule := range expected {
+			bundleRuleNames[rule.GetName()] = true
+		}
+		expectedRules[i] = expected
+	}
+
+	own := make([]*smartwebsecurity.SecurityRule, 0, len(rules))
+	for _, rule := range rules {
+		if !bundleRuleNames[rule.GetName()] {
+			own = append(own, rule)
+		}
+	}
+
+	// the automatic offsets are computed from the preceding rules the same way as in mergeSWSRuleBundles
+	preceding := append([]*smartwebsecurity.SecurityRule{}, own...)
+	flattened := make([]interface{}, 0, len(bundles))
+	for i, raw := range bundles {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		expected := expectedRules[i]
+		offset := swsRuleBundleOffset(int64(m["priority_offset"].(int)), preceding)
+
+		changed := false
+		actual := make([]*smartwebsecurity.SecurityRule, 0, len(expected))
+		compared := make([]*smartwebsecurity.SecurityRule, 0, len(expected))
+		for _, rule := range expected {
+			found, ok := byName[rule.GetName()]
+			if !ok {
+				changed = true
+				continue
+			}
+			preceding = append(preceding, found)
+
+			found = proto.Clone(found).(*smartwebsecurity.SecurityRule)
+			found.Priority -= offset
+			actual = append(actual, found)
+			compared = append(compared, rule)
+		}
+
+		if !changed {
+			equal, err := swsSecurityRulesEqual(compared, actual)
+			if err != nil {
+				return nil, nil, fmt.Errorf("rule_bundle.%d: %w", i, err)
+			}
+			changed = !equal
+		}
+
+		rulesJSON := m["rules_json"].(string)
+		if changed {
+			var err error
+			if rulesJSON, err = marshalSWSRuleBundle(actual); err != nil {
+				return nil, nil, err
+			}
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"rules_json":      rulesJSON,
+			"priority_offset": m["priority_offset"],
+		})
+	}
+
+	return own, flattened, nil
+}
+
+// swsSecurityRulesEqual compares the rules after a round trip through the security_rule schema, the same way
+// the own rules of the profile are compared, so that the fields and the defaults the API fills in
+// don't show up as a difference.
+func swsSecurityRulesEqual(expected, actual []*smartwebsecurity.SecurityRule) (bool, error) {
+	expected, err := swsSecurityRulesRoundTrip(expected)
+	if err != nil {
+		return false, err
+	}
+	actual, err = swsSecurityRulesRoundTrip(actual)
+	if err != nil {
+		return false, err
+	}
+
+	if len(expected) != len(actual) {
+		return false, nil
+	}
+	for i := range expected {
+		if !proto.Equal(expected[i], actual[i]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func swsSecurityRulesRoundTrip(rules []*smartwebsecurity.SecurityRule) ([]*smartwebsecurity.SecurityRule, error) {
+	flattened, err := flattenSmartwebsecuritySecurityRuleSlice(rules)
+	if err != nil {
+		return nil, err
+	}
+	d := resourceYandexSmartwebsecuritySecurityProfile().Data(nil)
+	if err := d.Set("security_rule", flattened); err != nil {
+		return nil, err
+	}
+	return expandSecurityProfileSecurityRulesSlice(d)
+}
+
+// validateSWSSecurityRules checks that the names and the priorities of the rules are unique,
+// and that the rules have no conditions that can never match.
+func validateSWSSecurityRules(rules []*smartwebsecurity.SecurityRule) error {
+	names := make(map[string]bool, len(rules))
+	priorities := make(map[int64]string, len(rules))
+	for _, rule := range rules {
+		// the name and the priority are optional in the schema, the unset ones are left to the API to check
+		if rule.GetName() != "" {
+			if names[rule.GetName()] {
+				return fmt.Errorf("security rule name %q is used more than once", rule.GetName())
+			}
+			names[rule.GetName()] = true
+		}
+
+		if rule.GetPriority() != 0 {
+			if rule.GetPriority() < 1 || rule.GetPriority() > swsMaxRulePriority {
+				return fmt.Errorf("priority %d of security rule %q is out of range [1, %d]", rule.GetPriority(), rule.GetName(), swsMaxRulePriority)
+			}
+			if other, ok := priorities[rule.GetPriority()]; ok {
+				return fmt.Errorf("security rules %q and %q have the same priority %d", other, rule.GetName(), rule.GetPriority())
+			}
+			priorities[rule.GetPriority()] = rule.GetName()
+		}
+
+		if err := validateSWSSecurityRuleCondition(swsSecurityRuleCondition(rule)); err != nil {
+			return fmt.Errorf("security rule %q can never match: %w", rule.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func swsSecurityRuleCondition(rule *smartwebsecurity.SecurityRule) *smartwebsecurity.Condition {
+	switch {
+	case rule.GetRuleCondition() != nil:
+		return rule.GetRuleCondition().GetCondition()
+	case rule.GetSmartProtection() != nil:
+		return rule.GetSmartProtection().GetCondition()
+	case rule.GetWaf() != nil:
+		return rule.GetWaf().GetCondition()
+	}
+	return nil
+}
+
+// validateSWSSecurityRuleCondition finds the source IP matchers that exclude every address they match.
+func validateSWSSecurityRuleCondition(condition *smartwebsecurity.Condition) error {
+	sourceIP := condition.GetSourceIp()
+	if sourceIP == nil {
+		return nil
+	}
+
+	if m := sourceIP.GetGeoIpMatch(); m != nil {
+		if len(m.GetLocations()) == 0 {
+			return fmt.Errorf("geo_ip_match has no locations")
+		}
+		if notMatch := sourceIP.GetGeoIpNotMatch(); notMatch != nil && isSubset(m.GetLocations(), notMatch.GetLocations()) {
+			return fmt.Errorf("all locations of geo_ip_match are excluded by geo_ip_not_match")
+		}
+	}
+
+	if m := sourceIP.GetIpRangesMatch(); m != nil {
+		if len(m.GetIpRanges()) == 0 {
+			return fmt.Errorf("ip_ranges_match has no IP ranges")
+		}
+		if notMatch := sourceIP.GetIpRangesNotMatch(); notMatch != nil && swsIPRangesCovered(m.GetIpRanges(), notMatch.GetIpRanges()) {
+			return fmt.Errorf("all IP ranges of ip_ranges_match are excluded by ip_ranges_not_match")
+		}
+	}
+	return nil
+}
+
+func isSubset(values, of []string) bool {
+	set := make(map[string]bool, len(of))
+	for _, v := range of {
+		set[v] = true
+	}
+	for _, v := range values {
+		if !set[v] {
+			return false
+		}
+	}
+	return true
+}
+
+// swsIPRangesCovered reports whether every range is contained in one of the excluded ranges.
+// The ranges that aren't prefixes or addresses are compared as strings.
+func swsIPRangesCovered(ranges, excluded []string) bool {
+	for _, r := range ranges {
+		covered := false
+		prefix, prefixErr := parseSWSIPRange(r)
+		for _, e := range excluded {
+			if r == e {
+				covered = true
+				break
+			}
+			excludedPrefix, err := parseSWSIPRange(e)
+			if prefixErr == nil && err == nil && excludedPrefix.Bits() <= prefix.Bits() && excludedPrefix.Contains(prefix.Addr()) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+func parseSWSIPRange(r string) (netip.Prefix, error) {
+	if prefix, err := netip.ParsePrefix(r); err == nil {
+		return prefix.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(r)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// swsSecurityRuleFromMap builds the fields of a rule checked at plan time from the raw value of a security_rule
+// block: the name, the priority and the source IP matchers of the condition.
+func swsSecurityRuleFromMap(m map[string]interface{}) *smartwebsecurity.SecurityRule {
+	rule := &smartwebsecurity.SecurityRule{
+		Name:     m["name"].(string),
+		Priority: int64(m["priority"].(int)),
+	}
+
+	var condition *smartwebsecurity.Condition
+	for _, specifier := range []string{"rule_condition", "smart_protection", "waf"} {
+		if c := swsFirstMap(swsFirstMap(m, specifier), "condition"); c != nil {
+			condition = &smartwebsecurity.Condition{SourceIp: swsIPMatcherFromMap(swsFirstMap(c, "source_ip"))}
+		}
+	}
+	rule.RuleSpecifier = &smartwebsecurity.SecurityRule_RuleCondition_{
+		RuleCondition: &smartwebsecurity.SecurityRule_RuleCondition{Condition: condition},
+	}
+	return rule
+}
+
+func swsIPMatcherFromMap(m map[string]interface{}) *smartwebsecurity.Condition_IpMatcher {
+	if m == nil {
+		return nil
+	}
+
+	matcher := new(smartwebsecurity.Condition_IpMatcher)
+	if v, ok := m["geo_ip_match"].([]interface{}); ok && len(v) > 0 {
+		matcher.GeoIpMatch = &smartwebsecurity.Condition_GeoIpMatcher{Locations: swsStrings(swsFirstMap(m, "geo_ip_match"), "locations")}
+	}
+	if v, ok := m["geo_ip_not_match"].([]interface{}); ok && len(v) > 0 {
+		matcher.GeoIpNotMatch = &smartwebsecurity.Condition_GeoIpMatcher{Locations: swsStrings(swsFirstMap(m, "geo_ip_not_match"), "locations")}
+	}
+	if v, ok := m["ip_ranges_match"].([]interface{}); ok && len(v) > 0 {
+		matcher.IpRangesMatch = &smartwebsecurity.Condition_IpRangesMatcher{IpRanges: swsStrings(swsFirstMap(m, "ip_ranges_match"), "ip_ranges")}
+	}
+	if v, ok := m["ip_ranges_not_match"].([]interface{}); ok && len(v) > 0 {
+		matcher.IpRangesNotMatch = &smartwebsecurity.Condition_IpRangesMatcher{IpRanges: swsStrings(swsFirstMap(m, "ip_ranges_not_match"), "ip_ranges")}
+	}
+	return matcher
+}
+
+// swsFirstMap returns the only element of a block with MaxItems 1, an empty block is returned as an empty map.
+func swsFirstMap(m map[string]interface{}, key string) map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+	v, ok := m[key].([]interface{})
+	if !ok || len(v) == 0 {
+		return nil
+	}
+	if first, ok := v[0].(map[string]interface{}); ok {
+		return first
+	}
+	return map[string]interface{}{}
+}
+
+func swsStrings(m map[string]interface{}, key string) []string {
+	if m == nil {
+		return nil
+	}
+	v, _ := m[key].([]interface{})
+	result := make([]string, 0, len(v))
+	for _, s := range v {
+		if s, ok := s.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func swsRuleBundleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Rule bundles shared with other security profiles, see `yandex_sws_rule_bundle` data source. The rules of the bundles are added after `security_rule`, in the order of the bundles.",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"rules_json": {
+					Type:         schema.TypeString,
+					Description:  "Rules of the bundle, the `rules_json` attribute of `yandex_sws_rule_bundle` data source.",
+					Required:     true,
+					ValidateFunc: validation.StringIsJSON,
+					StateFunc: func(v interface{}) string {
+						json, _ := structure.NormalizeJsonString(v)
+						return json
+					},
+				},
+				"priority_offset": {
+					Type:         schema.TypeInt,
+					Description:  "Offset added to the priorities of the rules of the bundle, so that the same bundle can be placed at different positions in different profiles. If not set or 0, the rules of the bundle are renumbered automatically to follow the highest priority of the preceding rules of the profile and bundles.",
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, swsMaxRulePriority-1),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,249 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/smartwebsecurity/v1"
+)
+
+func testSWSRule(name string, priority int64, sourceIP *smartwebsecurity.Condition_IpMatcher) *smartwebsecurity.SecurityRule {
+	return &smartwebsecurity.SecurityRule{
+		Name:     name,
+		Priority: priority,
+		RuleSpecifier: &smartwebsecurity.SecurityRule_RuleCondition_{
+			RuleCondition: &smartwebsecurity.SecurityRule_RuleCondition{
+				Action:    smartwebsecurity.SecurityRule_RuleCondition_DENY,
+				Condition: &smartwebsecurity.Condition{SourceIp: sourceIP},
+			},
+		},
+	}
+}
+
+func TestMergeSWSRuleBundles(t *testing.T) {
+	bundleRules := []*smartwebsecurity.SecurityRule{testSWSRule("deny-tor", 10, nil), testSWSRule("deny-bots", 20, nil)}
+	rulesJSON, err := marshalSWSRuleBundle(bundleRules)
+	require.NoError(t, err)
+
+	bundles, err := expandSWSRuleBundles([]interface{}{
+		map[string]interface{}{"rules_json": rulesJSON, "priority_offset": 1000},
+	})
+	require.NoError(t, err)
+
+	own := []*smartwebsecurity.SecurityRule{testSWSRule("allow-office", 1, nil)}
+	merged, err := mergeSWSRuleBundles(own, bundles)
+	require.NoError(t, err)
+
+	var names []string
+	var priorities []int64
+	for _, rule := range merged {
+		names = append(names, rule.GetName())
+		priorities = append(priorities, rule.GetPriority())
+	}
+	assert.Equal(t, []string{"allow-office", "deny-tor", "deny-bots"}, names)
+	assert.Equal(t, []int64{1, 1010, 1020}, priorities)
+	assert.Equal(t, int64(10), bundleRules[0].GetPriority(), "rules of the bundle must not be modified")
+
+	_, err = mergeSWSRuleBundles([]*smartwebsecurity.SecurityRule{testSWSRule("allow-office", 1010, nil)}, bundles)
+	assert.ErrorContains(t, err, "have the same priority 1010")
+
+	_, err = mergeSWSRuleBundles([]*smartwebsecurity.SecurityRule{testSWSRule("deny-tor", 1, nil)}, bundles)
+	assert.ErrorContains(t, err, `name "deny-tor" is used more than once`)
+
+	_, err = mergeSWSRuleBundles(nil, []swsRuleBundle{{rules: bundleRules, priorityOffset: swsMaxRulePriority - 15}})
+	assert.ErrorContains(t, err, "out of range")
+}
+
+func TestMergeSWSRuleBundlesAutomaticOffset(t *testing.T) {
+	first := []*smartwebsecurity.SecurityRule{testSWSRule("deny-tor", 10, nil), testSWSRule("deny-bots", 20, nil)}
+	second := []*smartwebsecurity.SecurityRule{testSWSRule("allow-health", 5, nil)}
+	own := []*smartwebsecurity.SecurityRule{testSWSRule("allow-office", 100, nil), testSWSRule("allow-vpn", 50, nil)}
+
+	merged, err := mergeSWSRuleBundles(own, []swsRuleBundle{{rules: first}, {rules: second}})
+	require.NoError(t, err)
+
+	var priorities []int64
+	for _, rule := range merged {
+		priorities = append(priorities, rule.GetPriority())
+	}
+	assert.Equal(t, []int64{100, 50, 110, 120, 125}, priorities)
+
+	// the bundles are renumbered after the own rules change
+	own[0] = testSWSRule("allow-office", 200, nil)
+	merged, err = mergeSWSRuleBundles(own, []swsRuleBundle{{rules: first}, {rules: second}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(210), merged[2].GetPriority())
+	assert.Equal(t, int64(225), merged[4].GetPriority())
+}
+
+func TestSplitSWSRuleBundlesAutomaticOffset(t *testing.T) {
+	firstJSON, err := marshalSWSRuleBundle([]*smartwebsecurity.SecurityRule{testSWSRule("deny-tor", 10, nil), testSWSRule("deny-bots", 20, nil)})
+	require.NoError(t, err)
+	secondJSON, err := marshalSWSRuleBundle([]*smartwebsecurity.SecurityRule{testSWSRule("allow-health", 5, nil)})
+	require.NoError(t, err)
+	state := []interface{}{
+		map[string]interface{}{"rules_json": firstJSON, "priority_offset": 0},
+		map[string]interface{}{"rules_json": secondJSON, "priority_offset": 0},
+	}
+
+	actual := []*smartwebsecurity.SecurityRule{
+		testSWSRule("allow-office", 100, nil),
+		testSWSRule("deny-tor", 110, nil),
+		testSWSRule("deny-bots", 120, nil),
+		testSWSRule("allow-health", 125, nil),
+	}
+	own, bundles, err := splitSWSRuleBundles(actual, state)
+	require.NoError(t, err)
+	require.Len(t, own, 1)
+	assert.Equal(t, state, bundles)
+}
+
+func TestSplitSWSRuleBundlesComparesFlattenedRules(t *testing.T) {
+	withPath := func(priority int64, path *smartwebsecurity.Condition_StringMatcher) *smartwebsecurity.SecurityRule {
+		rule := testSWSRule("deny-tor", priority, nil)
+		rule.GetRuleCondition().GetCondition().RequestUri = &smartwebsecurity.Condition_RequestUriMatcher{Path: path}
+		return rule
+	}
+
+	rulesJSON, err := marshalSWSRuleBundle([]*smartwebsecurity.SecurityRule{withPath(10, &smartwebsecurity.Condition_StringMatcher{})})
+	require.NoError(t, err)
+	state := []interface{}{map[string]interface{}{"rules_json": rulesJSON, "priority_offset": 100}}
+
+	// the API returns an empty exact match that isn't kept in the state
+	actual := []*smartwebsecurity.SecurityRule{withPath(110, &smartwebsecurity.Condition_StringMatcher{
+		Match: &smartwebsecurity.Condition_StringMatcher_ExactMatch{},
+	})}
+	_, bundles, err := splitSWSRuleBundles(actual, state)
+	require.NoError(t, err)
+	assert.Equal(t, state, bundles)
+}
+
+func TestSplitSWSRuleBundles(t *testing.T) {
+	rulesJSON, err := marshalSWSRuleBundle([]*smartwebsecurity.SecurityRule{testSWSRule("deny-tor", 10, nil), testSWSRule("deny-bots", 20, nil)})
+	require.NoError(t, err)
+	state := []interface{}{map[string]interface{}{"rules_json": rulesJSON, "priority_offset": 100}}
+
+	actual := []*smartwebsecurity.SecurityRule{
+		testSWSRule("allow-office", 1, nil),
+		testSWSRule("deny-tor", 110, nil),
+		testSWSRule("deny-bots", 120, nil),
+	}
+	own, bundles, err := splitSWSRuleBundles(actual, state)
+	require.NoError(t, err)
+	require.Len(t, own, 1)
+	assert.Equal(t, "allow-office", own[0].GetName())
+	assert.Equal(t, state, bundles)
+
+	// the rule of the bundle changed outside of Terraform
+	actual[2] = testSWSRule("deny-bots", 130, nil)
+	_, bundles, err = splitSWSRuleBundles(actual[:3], state)
+	require.NoError(t, err)
+	rules, err := unmarshalSWSRuleBundle(bundles[0].(map[string]interface{})["rules_json"].(string))
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	assert.Equal(t, int64(30), rules[1].GetPriority())
+
+	// the rule of the bundle removed outside of Terraform
+	_, bundles, err = splitSWSRuleBundles(actual[:2], state)
+	require.NoError(t, err)
+	rules, err = unmarshalSWSRuleBundle(bundles[0].(map[string]interface{})["rules_json"].(string))
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	assert.Equal(t, "deny-tor", rules[0].GetName())
+}
+
+func TestValidateSWSSecurityRuleCondition(t *testing.T) {
+	cases := []struct {
+		name     string
+		sourceIP *smartwebsecurity.Condition_IpMatcher
+		err      string
+	}{
+		{
+			name: "no source ip",
+		},
+		{
+			name: "empty geo match",
+			sourceIP: &smartwebsecurity.Condition_IpMatcher{
+				GeoIpMatch: &smartwebsecurity.Condition_GeoIpMatcher{},
+			},
+			err: "geo_ip_match has no locations",
+		},
+		{
+			name: "excluded locations",
+			sourceIP: &smartwebsecurity.Condition_IpMatcher{
+				GeoIpMatch:    &smartwebsecurity.Condition_GeoIpMatcher{Locations: []string{"ru"}},
+				GeoIpNotMatch: &smartwebsecurity.Condition_GeoIpMatcher{Locations: []string{"ru", "kz"}},
+			},
+			err: "all locations of geo_ip_match are excluded",
+		},
+		{
+			name: "partly excluded locations",
+			sourceIP: &smartwebsecurity.Condition_IpMatcher{
+				GeoIpMatch:    &smartwebsecurity.Condition_GeoIpMatcher{Locations: []string{"ru", "by"}},
+				GeoIpNotMatch: &smartwebsecurity.Condition_GeoIpMatcher{Locations: []string{"ru", "kz"}},
+			},
+		},
+		{
+			name: "excluded ranges",
+			sourceIP: &smartwebsecurity.Condition_IpMatcher{
+				IpRangesMatch:    &smartwebsecurity.Condition_IpRangesMatcher{IpRanges: []string{"10.0.1.0/24", "10.0.2.5"}},
+				IpRangesNotMatch: &smartwebsecurity.Condition_IpRangesMatcher{IpRanges: []string{"10.0.0.0/16"}},
+			},
+			err: "all IP ranges of ip_ranges_match are excluded",
+		},
+		{
+			name: "partly excluded ranges",
+			sourceIP: &smartwebsecurity.Condition_IpMatcher{
+				IpRangesMatch:    &smartwebsecurity.Condition_IpRangesMatcher{IpRanges: []string{"10.0.0.0/8"}},
+				IpRangesNotMatch: &smartwebsecurity.Condition_IpRangesMatcher{IpRanges: []string{"10.0.0.0/16"}},
+			},
+		},
+		{
+			name: "excluded address ranges",
+			sourceIP: &smartwebsecurity.Condition_IpMatcher{
+				IpRangesMatch:    &smartwebsecurity.Condition_IpRangesMatcher{IpRanges: []string{"10.0.0.1-10.0.0.5"}},
+				IpRangesNotMatch: &smartwebsecurity.Condition_IpRangesMatcher{IpRanges: []string{"10.0.0.1-10.0.0.5"}},
+			},
+			err: "all IP ranges of ip_ranges_match are excluded",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateSWSSecurityRules([]*smartwebsecurity.SecurityRule{testSWSRule("rule", 1, tc.sourceIP)})
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestSWSSecurityRuleFromMap(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceYandexSmartwebsecuritySecurityProfile().Schema, map[string]interface{}{
+		"security_rule": []interface{}{
+			map[string]interface{}{
+				"name":     "deny-nowhere",
+				"priority": 5,
+				"rule_condition": []interface{}{map[string]interface{}{
+					"action": "DENY",
+					"condition": []interface{}{map[string]interface{}{
+						"source_ip": []interface{}{map[string]interface{}{
+							"geo_ip_match":     []interface{}{map[string]interface{}{"locations": []interface{}{"ru"}}},
+							"geo_ip_not_match": []interface{}{map[string]interface{}{"locations": []interface{}{"ru"}}},
+						}},
+					}},
+				}},
+			},
+		},
+	})
+
+	rule := swsSecurityRuleFromMap(d.Get("security_rule").([]interface{})[0].(map[string]interface{}))
+	assert.Equal(t, "deny-nowhere", rule.GetName())
+	assert.Equal(t, int64(5), rule.GetPriority())
+	assert.Equal(t, []string{"ru"}, rule.GetRuleCondition().GetCondition().GetSourceIp().GetGeoIpNotMatch().GetLocations())
+	assert.ErrorContains(t, validateSWSSecurityRules([]*smartwebsecurity.SecurityRule{rule}), "can never match")
+}
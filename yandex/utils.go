@@ -821,8 +821,16 @@ func convertResourceToDataSource(resource *schema.Resource) *schema.Resource {
 		schema.ForceNew = false
 		schema.Default = nil
 		schema.ValidateFunc = nil
+		schema.ValidateDiagFunc = nil
 		schema.MaxItems = 0
 		schema.MinItems = 0
+		schema.DefaultFunc = nil
+		schema.DiffSuppressFunc = nil
+		schema.StateFunc = nil
+		schema.ConflictsWith = nil
+		schema.ExactlyOneOf = nil
+		schema.AtLeastOneOf = nil
+		schema.RequiredWith = nil
 	})
 }
 
@@ -0,0 +1,157 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+// ydbIAMAuthCallback returns the credentials callback used by the YDB schema object resources.
+// It uses the cached IAM token of the provider, so that a plan touching many tables doesn't create a token for each of them.
+func ydbIAMAuthCallback(meta interface{}) auth.GetAuthCallback {
+	return func(ctx context.Context) (auth.YdbCredentials, error) {
+		config := meta.(*Config)
+		token, err := config.getIAMToken(ctx)
+		if err != nil {
+			return auth.YdbCredentials{}, err
+		}
+		return auth.YdbCredentials{Token: token}, nil
+	}
+}
+
+// openYDBConnection connects to the database of the connection string with the IAM token of the provider.
+// The returned driver must be closed by the caller.
+func openYDBConnection(ctx context.Context, meta interface{}, connectionString string) (*ydb.Driver, error) {
+	creds, err := ydbIAMAuthCallback(meta)(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token for YDB request: %w", err)
+	}
+
+	db, err := ydb.Open(ctx, connectionString, ydb.WithAccessTokenCredentials(creds.Token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to YDB database %q: %w", connectionString, err)
+	}
+	return db, nil
+}
+
+// ydbDatabasePath returns the path of the database from the connection string,
+// e.g. "/ru-central1/b1g.../etn..." for "grpcs://ydb.serverless.yandexcloud.net:2135/?database=/ru-central1/b1g.../etn...".
+func ydbDatabasePath(connectionString string) (string, error) {
+	u, err := url.Parse(connectionString)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse connection string %q: %w", connectionString, err)
+	}
+	database := u.Query().Get("database")
+	if database == "" {
+		database = strings.TrimSuffix(u.Path, "/")
+	}
+	if database == "" {
+		return "", fmt.Errorf("connection string %q has no database", connectionString)
+	}
+	return database, nil
+}
+
+// ydbFullPath returns the absolute path of the scheme object, given its path relative to the database root.
+func ydbFullPath(connectionString, path string) (string, error) {
+	database, err := ydbDatabasePath(connectionString)
+	if err != nil {
+		return "", err
+	}
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return database, nil
+	}
+	return database + "/" + path, nil
+}
+
+// ydbAccessID builds the ID of a YDB access resource from the connection string and the key-value pairs
+// identifying it in the database, e.g. "grpcs://host:2135/?database=/ru-central1/b1g/etn?user=reader".
+func ydbAccessID(connectionString string, keysAndValues ...string) string {
+	pairs := make([]string, 0, len(keysAndValues)/2)
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		pairs = append(pairs, keysAndValues[i]+"="+keysAndValues[i+1])
+	}
+	return connectionString + "?" + strings.Join(pairs, "&")
+}
+
+// parseYDBAccessID parses an ID built by ydbAccessID. The first key separates the connection string from the pairs.
+func parseYDBAccessID(id string, keys ...string) (string, map[string]string, error) {
+	i := strings.LastIndex(id, "?"+keys[0]+"=")
+	if i <= 0 {
+		return "", nil, fmt.Errorf("invalid ID %q, expected <connection_string>?%s=...", id, strings.Join(keys, "=...&"))
+	}
+
+	values := make(map[string]string, len(keys))
+	for _, pair := range strings.Split(id[i+1:], "&") {
+		k, v, _ := strings.Cut(pair, "=")
+		values[k] = v
+	}
+	for _, k := range keys {
+		if _, ok := values[k]; !ok {
+			return "", nil, fmt.Errorf("invalid ID %q, %q is missing", id, k)
+		}
+	}
+	return id[:i], values, nil
+}
+
+// quoteYDBIdentifier quotes a name to be used in YQL statements.
+func quoteYDBIdentifier(name string) string {
+	return "`" + strings.NewReplacer(`\`, `\\`, "`", "\\`").Replace(name) + "`"
+}
+
+// quoteYDBIdentifiers quotes a list of names to be used in YQL statements.
+func quoteYDBIdentifiers(names []string) string {
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, quoteYDBIdentifier(name))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// quoteYDBString quotes a value to be used as a string literal in YQL statements.
+func quoteYDBString(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, "'", `\'`).Replace(value) + "'"
+}
+
+func ydbAccessConnectionStringSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Connection string for database.",
+		Required:    true,
+		ForceNew:    true,
+	}
+}
+
+// queryYDBSids runs a query over the auth system views of the database with the $name parameter,
+// and returns the values of its only column.
+func queryYDBSids(ctx context.Context, db *ydb.Driver, yql, name string) ([]string, error) {
+	rs, err := db.Query().QueryResultSet(ctx, yql, query.WithParameters(
+		ydb.ParamsBuilder().Param("$name").Text(name).Build(),
+	))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rs.Close(ctx)
+	}()
+
+	var sids []string
+	for row, err := range rs.Rows(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		var sid string
+		if err := row.Scan(&sid); err != nil {
+			return nil, err
+		}
+		sids = append(sids, sid)
+	}
+	return sids, nil
+}
@@ -0,0 +1,83 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+)
+
+const testYDBConnectionString = "grpcs://ydb.serverless.yandexcloud.net:2135/?database=/ru-central1/b1gexample/etnexample"
+
+func TestYDBFullPath(t *testing.T) {
+	path, err := ydbFullPath(testYDBConnectionString, "dir/table")
+	require.NoError(t, err)
+	assert.Equal(t, "/ru-central1/b1gexample/etnexample/dir/table", path)
+
+	path, err = ydbFullPath(testYDBConnectionString, "")
+	require.NoError(t, err)
+	assert.Equal(t, "/ru-central1/b1gexample/etnexample", path)
+
+	path, err = ydbFullPath("grpc://localhost:2136/local", "/table/")
+	require.NoError(t, err)
+	assert.Equal(t, "/local/table", path)
+
+	_, err = ydbFullPath("grpc://localhost:2136", "table")
+	assert.Error(t, err)
+}
+
+func TestYDBAccessID(t *testing.T) {
+	id := ydbAccessID(testYDBConnectionString, "path", "dir/table", "subject", "ajeexample@as")
+	assert.Equal(t, testYDBConnectionString+"?path=dir/table&subject=ajeexample@as", id)
+
+	connectionString, values, err := parseYDBAccessID(id, "path", "subject")
+	require.NoError(t, err)
+	assert.Equal(t, testYDBConnectionString, connectionString)
+	assert.Equal(t, map[string]string{"path": "dir/table", "subject": "ajeexample@as"}, values)
+
+	connectionString, values, err = parseYDBAccessID(ydbAccessID(testYDBConnectionString, "path", "", "subject", "reader"), "path", "subject")
+	require.NoError(t, err)
+	assert.Equal(t, testYDBConnectionString, connectionString)
+	assert.Equal(t, map[string]string{"path": "", "subject": "reader"}, values)
+
+	_, _, err = parseYDBAccessID(testYDBConnectionString+"?user=reader", "path", "subject")
+	assert.Error(t, err)
+
+	_, _, err = parseYDBAccessID(testYDBConnectionString+"?path=table", "path", "subject")
+	assert.Error(t, err)
+}
+
+func TestQuoteYDB(t *testing.T) {
+	assert.Equal(t, "`reader`", quoteYDBIdentifier("reader"))
+	assert.Equal(t, "`a\\`b`", quoteYDBIdentifier("a`b"))
+	assert.Equal(t, "'pa\\'ss\\\\'", quoteYDBString(`pa'ss\`))
+}
+
+func TestYDBSubjectPermissions(t *testing.T) {
+	permissions := []scheme.Permissions{
+		{Subject: "reader", PermissionNames: []string{"ydb.generic.read"}},
+		{Subject: "writer", PermissionNames: []string{"ydb.generic.write"}},
+		{Subject: "reader", PermissionNames: []string{"ydb.database.connect"}},
+	}
+	assert.Equal(t, []string{"ydb.database.connect", "ydb.generic.read"}, ydbSubjectPermissions(permissions, "reader"))
+	assert.Empty(t, ydbSubjectPermissions(permissions, "admin"))
+}
+
+func TestYDBInheritanceInterrupted(t *testing.T) {
+	parent := scheme.Entry{EffectivePermissions: []scheme.Permissions{
+		{Subject: "reader", PermissionNames: []string{"ydb.generic.read"}},
+	}}
+
+	// all permissions of the parent are effective, the path may inherit or re-grant them
+	assert.False(t, ydbInheritanceInterrupted(scheme.Entry{EffectivePermissions: []scheme.Permissions{
+		{Subject: "reader", PermissionNames: []string{"ydb.generic.read"}},
+		{Subject: "writer", PermissionNames: []string{"ydb.generic.write"}},
+	}}, parent))
+
+	assert.True(t, ydbInheritanceInterrupted(scheme.Entry{EffectivePermissions: []scheme.Permissions{
+		{Subject: "writer", PermissionNames: []string{"ydb.generic.write"}},
+	}}, parent))
+
+	assert.False(t, ydbInheritanceInterrupted(scheme.Entry{}, scheme.Entry{}))
+}